@@ -0,0 +1,130 @@
+package hellosign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNoCredentials is returned by an Authenticator which has no credentials for a request, letting the
+// authenticators chained with [ChainAuthenticators] fall back to the next one.
+var ErrNoCredentials = errors.New("no credentials available")
+
+// Authenticator adds credentials to the requests sent by a Client, see [WithAuthenticator].  Authenticate is called
+// right before each attempt at sending a request, including retries, and must be safe for concurrent use.
+type Authenticator interface {
+	// Authenticate adds authentication header(s) to req, returning an error if it can't.  The context of the request
+	// is available from req.Context().
+	Authenticate(req *http.Request) error
+}
+
+// AuthenticatorFunc adapts a function to an Authenticator.
+type AuthenticatorFunc func(req *http.Request) error
+
+// Authenticate implements Authenticator
+func (f AuthenticatorFunc) Authenticate(req *http.Request) error {
+	return f(req)
+}
+
+// APIKeyAuthenticator authenticates requests using a Hellosign API key.
+func APIKeyAuthenticator(key string) Authenticator {
+	return AuthenticatorFunc(func(req *http.Request) error {
+		req.SetBasicAuth(key, "")
+		return nil
+	})
+}
+
+// APIKeySourceAuthenticator authenticates requests using the API key returned by key for each request, such as one
+// read from a secrets manager and rotated there.  key is called with the context of the request; it should cache the
+// key as needed, and return [ErrNoCredentials] if there is none.
+func APIKeySourceAuthenticator(key func(ctx context.Context) (string, error)) Authenticator {
+	return AuthenticatorFunc(func(req *http.Request) error {
+		k, err := key(req.Context())
+		if err != nil {
+			return err
+		}
+		if k == "" {
+			return ErrNoCredentials
+		}
+		req.SetBasicAuth(k, "")
+		return nil
+	})
+}
+
+// AccessTokenAuthenticator authenticates requests using an access token issued during an OAuth flow.  The token is
+// used as is, use [TokenSourceAuthenticator] to refresh it when it expires.
+func AccessTokenAuthenticator(token string) Authenticator {
+	return AuthenticatorFunc(func(req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// TokenSourceAuthenticator authenticates requests using the access tokens supplied by ts, such as the refreshing
+// TokenSource of an [OAuthConfig].  A nil ts, or one returning a nil token, has no credentials.
+func TokenSourceAuthenticator(ts TokenSource) Authenticator {
+	return AuthenticatorFunc(func(req *http.Request) error {
+		if ts == nil {
+			return ErrNoCredentials
+		}
+		t, err := ts.Token()
+		if err != nil {
+			return err
+		}
+		if t == nil || t.AccessToken == "" {
+			return ErrNoCredentials
+		}
+		req.Header.Set("Authorization", t.Type()+" "+t.AccessToken)
+		return nil
+	})
+}
+
+// ChainAuthenticators authenticates requests with the first of authenticators having credentials for them, e.g. to
+// act on behalf of a user when they connected their account with OAuth, and with an API key otherwise.  The next
+// authenticator is only tried when one fails with [ErrNoCredentials]; other errors, such as failing to refresh a
+// token, are returned so that a request is never silently sent on behalf of someone else.
+func ChainAuthenticators(authenticators ...Authenticator) Authenticator {
+	return AuthenticatorFunc(func(req *http.Request) error {
+		for _, a := range authenticators {
+			if a == nil {
+				continue
+			}
+			if err := a.Authenticate(req); !errors.Is(err, ErrNoCredentials) {
+				return err
+			}
+		}
+		return ErrNoCredentials
+	})
+}
+
+// requestAuthenticatorKey is the context key of the Authenticator of a request
+type requestAuthenticatorKey struct{}
+
+// WithRequestAuthenticator returns a context authenticating the requests created with it using the Authenticator a
+// instead of the one of the Client, e.g. to act on behalf of many OAuth connected accounts with a single Client.
+func WithRequestAuthenticator(ctx context.Context, a Authenticator) context.Context {
+	return context.WithValue(ctx, requestAuthenticatorKey{}, a)
+}
+
+// requestAuthenticator returns the Authenticator set on ctx with WithRequestAuthenticator, if any
+func requestAuthenticator(ctx context.Context) (Authenticator, bool) {
+	a, ok := ctx.Value(requestAuthenticatorKey{}).(Authenticator)
+	return a, ok && a != nil
+}
+
+// authenticate adds credentials to the request, using the Authenticator of its context if it has one, or the one of
+// the client if it is configured with one
+func (c *Client) authenticate(req *http.Request) error {
+	a, ok := requestAuthenticator(req.Context())
+	if !ok {
+		a = c.auth
+	}
+	if a == nil {
+		return nil
+	}
+	if err := a.Authenticate(req); err != nil {
+		return fmt.Errorf("authenticating request: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package hellosign_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sean-rn/hellosign-sdk"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAuthServer returns a server recording the Authorization header of the requests it receives
+func newAuthServer(t *testing.T) (*httptest.Server, *[]string) {
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"signature_request": {"signature_request_id": "fa5c8a0b0f492d768749333ad6fcc214c111e967"}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &authorizations
+}
+
+func TestAPIKeySourceAuthenticatorRotates(t *testing.T) {
+	server, authorizations := newAuthServer(t)
+	keys := []string{"first-key", "second-key"}
+	var calls atomic.Int32
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL),
+		hellosign.WithAuthenticator(hellosign.APIKeySourceAuthenticator(func(ctx context.Context) (string, error) {
+			return keys[calls.Add(1)-1], nil
+		})))
+
+	for range keys {
+		_, err := client.GetSignatureRequest(context.Background(), "fa5c8a0b0f492d768749333ad6fcc214c111e967")
+		require.NoError(t, err)
+	}
+	// Basic auth of "<key>:"
+	assert.Equal(t, []string{"Basic Zmlyc3Qta2V5Og==", "Basic c2Vjb25kLWtleTo="}, *authorizations)
+}
+
+func TestWithRequestAuthenticator(t *testing.T) {
+	server, authorizations := newAuthServer(t)
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("app-key"))
+
+	ctx := hellosign.WithRequestAuthenticator(context.Background(), hellosign.AccessTokenAuthenticator("account-token"))
+	_, err := client.GetSignatureRequest(ctx, "fa5c8a0b0f492d768749333ad6fcc214c111e967")
+	require.NoError(t, err)
+	_, err = client.GetSignatureRequest(context.Background(), "fa5c8a0b0f492d768749333ad6fcc214c111e967")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer account-token", "Basic YXBwLWtleTo="}, *authorizations)
+}
+
+func TestChainAuthenticators(t *testing.T) {
+	server, authorizations := newAuthServer(t)
+	var token *hellosign.Token // No account connected with OAuth yet
+	tokens := hellosign.TokenSourceFunc(func() (*hellosign.Token, error) { return token, nil })
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithAuthenticator(
+		hellosign.ChainAuthenticators(hellosign.TokenSourceAuthenticator(tokens), hellosign.APIKeyAuthenticator("app-key"))))
+
+	_, err := client.GetSignatureRequest(context.Background(), "fa5c8a0b0f492d768749333ad6fcc214c111e967")
+	require.NoError(t, err)
+	token = &hellosign.Token{AccessToken: "account-token"}
+	_, err = client.GetSignatureRequest(context.Background(), "fa5c8a0b0f492d768749333ad6fcc214c111e967")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Basic YXBwLWtleTo=", "Bearer account-token"}, *authorizations)
+}
+
+func TestChainAuthenticatorsDoesNotFallBackOnErrors(t *testing.T) {
+	server, authorizations := newAuthServer(t)
+	errRefresh := errors.New("refresh failed")
+	tokens := hellosign.TokenSourceFunc(func() (*hellosign.Token, error) { return nil, errRefresh })
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithAuthenticator(
+		hellosign.ChainAuthenticators(hellosign.TokenSourceAuthenticator(tokens), hellosign.APIKeyAuthenticator("app-key"))))
+
+	_, err := client.GetSignatureRequest(context.Background(), "fa5c8a0b0f492d768749333ad6fcc214c111e967")
+	assert.ErrorIs(t, err, errRefresh)
+	assert.Empty(t, *authorizations, "the request must not be sent with the API key")
+
+	client = hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithAuthenticator(
+		hellosign.ChainAuthenticators(hellosign.TokenSourceAuthenticator(nil))))
+	_, err = client.GetSignatureRequest(context.Background(), "fa5c8a0b0f492d768749333ad6fcc214c111e967")
+	assert.ErrorIs(t, err, hellosign.ErrNoCredentials)
+}
@@ -14,31 +14,238 @@ const (
 // API declares the methods of Client as an interface for your convenience.
 type API interface {
 	// DownloadFiles Obtain a copy of the current documents specified by the `signature_request_id` parameter.
-	// Returns a PDF or ZIP file. If the files are currently being prepared, a status code of `409` will be returned instead.
+	// Returns a PDF or ZIP file. If the files are currently being prepared, a status code of `409` will be returned instead,
+	// unless the client is configured to wait for them using [WithFilesWaitPolicy].
 	// Parameters:
 	//   - signatureRequestId The id of the SignatureRequest to retrieve.
 	//   - fileType Set to "pdf" for a single merged document or "zip" for a collection of individual documents.
 	DownloadFiles(ctx context.Context, signatureRequestId, fileType string) ([]byte, error)
 
+	// DownloadFilesStream is like DownloadFiles, but returns the contents as a stream instead of reading them into memory.
+	// The caller must close the returned FileDownload.
+	DownloadFilesStream(ctx context.Context, signatureRequestId, fileType string) (*FileDownload, error)
+
+	// FilesAsDataUri Obtain a copy of the current documents specified by the `signature_request_id` parameter.
+	// Returns a JSON object with a `data_uri` representing the base64 encoded file (PDFs only). If the files are currently
+	// being prepared, a status code of `409` will be returned instead, unless the client is configured to wait for them
+	// using [WithFilesWaitPolicy].
+	// Parameters:
+	//   - signatureRequestId The id of the SignatureRequest to retrieve.
+	FilesAsDataUri(ctx context.Context, signatureRequestId string) (*model.FileResponseDataUri, error)
+
+	// FilesAsFileUrl Obtain a copy of the current documents specified by the `signature_request_id` parameter.
+	// Returns a JSON object with a url to the file (PDFs only). If the files are currently being prepared, a status code
+	// of `409` will be returned instead, unless the client is configured to wait for them using [WithFilesWaitPolicy].
+	// Parameters:
+	//   - signatureRequestId The id of the SignatureRequest to retrieve.
+	//   - forceDownload Whether the url returned causes the file to be downloaded (true) or rendered in the browser (false).
+	FilesAsFileUrl(ctx context.Context, signatureRequestId string, forceDownload bool) (*model.FileResponse, error)
+
 	// Create Embedded Signature Request with Template
 	// Creates a new SignatureRequest based on the given Template(s) to be signed in an embedded iFrame.
 	// Note that embedded signature requests can only be signed in embedded iFrames whereas normal signature requests
 	// can only be signed on Dropbox Sign.
 	CreateEmbeddedWithTemplate(ctx context.Context, req model.CreateEmbeddedWithTemplateRequest) (*model.SignatureRequestGetResponse, error)
 
+	// Create Embedded Signature Request
+	// Creates a new SignatureRequest with the submitted documents to be signed in an embedded iFrame. If form_fields_per_document is
+	// not specified, a signature page will be affixed where all signers will be required to add their signature, signifying their
+	// agreement to all contained documents. Note that embedded signature requests can only be signed in embedded iFrames whereas
+	// normal signature requests can only be signed on Dropbox Sign.
+	CreateEmbedded(ctx context.Context, req model.CreateEmbeddedRequest) (*model.SignatureRequestGetResponse, error)
+
+	// SendSignatureRequest Creates and sends a new SignatureRequest with the submitted documents. If `form_fields_per_document` is not
+	// specified, a signature page will be affixed where all signers will be required to add their signature, signifying their agreement
+	// to all contained documents.
+	SendSignatureRequest(ctx context.Context, req model.SendSignatureRequestRequest) (*model.SignatureRequestGetResponse, error)
+
+	// SendWithTemplate Creates and sends a new SignatureRequest based off of the Template(s) specified with the `template_ids`
+	// parameter.
+	SendWithTemplate(ctx context.Context, req model.SendWithTemplateRequest) (*model.SignatureRequestGetResponse, error)
+
+	// GetSignatureRequest Returns the status of the SignatureRequest specified by the `signature_request_id` parameter.
+	// Parameters:
+	//   - signatureRequestId The id of the SignatureRequest to retrieve.
+	GetSignatureRequest(ctx context.Context, signatureRequestId string) (*model.SignatureRequestGetResponse, error)
+
+	// RemindSignatureRequest Sends an email to the signer reminding them to sign the signature request. You cannot send a reminder
+	// within 1 hour of the last reminder that was sent. This includes manual AND automatic reminders.  Fails with
+	// [ErrEmbeddedSignatureRequest] for embedded signature requests.
+	RemindSignatureRequest(ctx context.Context, signatureRequestId string, req model.RemindSignatureRequestRequest) (*model.SignatureRequestGetResponse, error)
+
+	// UpdateSignatureRequest Updates the email address and/or the name for a given signer on a signature request, or the
+	// expiration date of the signature request.  The email address cannot be updated for embedded signature requests.
+	UpdateSignatureRequest(ctx context.Context, signatureRequestId string, req model.UpdateSignatureRequestRequest) (*model.SignatureRequestGetResponse, error)
+
+	// CancelSignatureRequest Cancels an incomplete signature request. This action is **not reversible**.  Fails with
+	// [ErrSignatureRequestComplete] for complete signature requests.
+	CancelSignatureRequest(ctx context.Context, signatureRequestId string) error
+
+	// RemoveSignatureRequest Removes your access to a completed signature request. This action is **not reversible**.
+	RemoveSignatureRequest(ctx context.Context, signatureRequestId string) error
+
+	// ReleaseHold Releases a held SignatureRequest that was claimed and prepared from an UnclaimedDraft.  Fails with
+	// [ErrSignatureRequestNotOnHold] if the signature request is not on hold.
+	ReleaseHold(ctx context.Context, signatureRequestId string) (*model.SignatureRequestGetResponse, error)
+
+	// ListSignatureRequests Returns a list of SignatureRequests that you can access. This includes SignatureRequests you have sent as
+	// well as received, but not ones that you have been CCed on.  Take a look at our search guide to learn more about querying
+	// signature requests.
+	ListSignatureRequests(ctx context.Context, req model.ListSignatureRequestsRequest) (*model.SignatureRequestListResponse, error)
+
+	// ListSignatureRequestsPager returns a Pager over all the SignatureRequests matching req, fetching the pages of
+	// ListSignatureRequests as needed.  req.Page is the first page fetched unless opts.StartPage is set.
+	ListSignatureRequestsPager(ctx context.Context, req model.ListSignatureRequestsRequest, opts PagerOptions) *Pager[model.SignatureRequestResponse]
+
 	// Retrieves an embedded object containing a signature url that can be opened in an iFrame.
 	// Parameters:
 	//   - signatureId The id of the signature to get a signature url for.
 	GetEmbeddedSignUrl(ctx context.Context, signatureId string) (*model.EmbeddedSignUrlResponse, error)
+
+	// Retrieves an embedded object containing a template url that can be opened in an iFrame. Note that only templates created via the
+	// embedded template process are available to be edited with this endpoint.
+	// Parameters:
+	//   - templateId The id of the template to edit.
+	//   - opts Options of the editor, and changes to apply to the template.
+	GetEmbeddedEditUrl(ctx context.Context, templateId string, opts model.EmbeddedEditUrlRequest) (*model.EmbeddedEditUrlResponse, error)
+
+	// BulkSendWithTemplate Creates BulkSendJob which sends up to 250 SignatureRequests in bulk based off of the provided Template(s)
+	// specified with the `template_ids` parameter.  **NOTE:** Only available for Premium plan and higher.
+	BulkSendWithTemplate(ctx context.Context, req model.SignatureRequestBulkSendWithTemplateRequest) (*model.BulkSendJobSendResponse, error)
+
+	// BulkCreateEmbeddedWithTemplate Creates BulkSendJob which sends up to 250 SignatureRequests in bulk based off of the provided
+	// Template(s) specified with the `template_ids` parameter to be signed in an embedded iFrame. These embedded signature requests
+	// can only be signed in embedded iFrames whereas normal signature requests can only be signed on Dropbox Sign.  **NOTE:** Only
+	// available for Enterprise plan.
+	BulkCreateEmbeddedWithTemplate(ctx context.Context, req model.SignatureRequestBulkCreateEmbeddedWithTemplateRequest) (*model.BulkSendJobSendResponse, error)
+
+	// GetBulkSendJob Returns the status of the BulkSendJob and its SignatureRequests specified by the `bulk_send_job_id` parameter.
+	// Parameters:
+	//   - bulkSendJobId The id of the BulkSendJob to retrieve.
+	//   - req The page of the SignatureRequests of the BulkSendJob to return.
+	GetBulkSendJob(ctx context.Context, bulkSendJobId string, req model.GetBulkSendJobRequest) (*model.BulkSendJobGetResponse, error)
+
+	// BulkSendJobSignatureRequestsPager returns a Pager over all the SignatureRequests of a BulkSendJob, fetching the pages
+	// of GetBulkSendJob as needed.  req.Page is the first page fetched unless opts.StartPage is set.
+	BulkSendJobSignatureRequestsPager(ctx context.Context, bulkSendJobId string, req model.GetBulkSendJobRequest, opts PagerOptions) *Pager[model.SignatureRequestResponse]
+
+	// ListBulkSendJobs Returns a list of BulkSendJob that you can access.
+	ListBulkSendJobs(ctx context.Context, req model.ListBulkSendJobsRequest) (*model.BulkSendJobListResponse, error)
+
+	// ListBulkSendJobsPager returns a Pager over all the BulkSendJobs, fetching the pages of ListBulkSendJobs as needed.
+	// req.Page is the first page fetched unless opts.StartPage is set.
+	ListBulkSendJobsPager(ctx context.Context, req model.ListBulkSendJobsRequest, opts PagerOptions) *Pager[model.BulkSendJobResponse]
+
+	// CreateUnclaimedDraft Creates a new Draft that can be claimed using the claim URL. The first authenticated user to access the URL
+	// will claim the Draft and will be shown either the "Sign and send" or the "Request signature" page with the Draft loaded.
+	// Subsequent access to the claim URL will result in a 404.
+	CreateUnclaimedDraft(ctx context.Context, req model.UnclaimedDraftCreateRequest) (*model.UnclaimedDraftCreateResponse, error)
+
+	// CreateEmbeddedUnclaimedDraft Creates a new Draft that can be claimed and used in an embedded iFrame. The first authenticated
+	// user to access the URL will claim the Draft and will be shown the "Request signature" page with the Draft loaded. Subsequent
+	// access to the claim URL will result in a `404`. For this embedded endpoint the `requester_email_address` parameter is required.
+	CreateEmbeddedUnclaimedDraft(ctx context.Context, req model.UnclaimedDraftCreateEmbeddedRequest) (*model.UnclaimedDraftCreateResponse, error)
+
+	// CreateEmbeddedUnclaimedDraftWithTemplate Creates a new Draft with a previously saved template(s) that can be claimed and used
+	// in an embedded iFrame. The first authenticated user to access the URL will claim the Draft and will be shown the "Request
+	// signature" page with the Draft loaded. Subsequent access to the claim URL will result in a `404`. For this embedded endpoint the
+	// `requester_email_address` parameter is required.
+	CreateEmbeddedUnclaimedDraftWithTemplate(ctx context.Context, req model.UnclaimedDraftCreateEmbeddedWithTemplateRequest) (*model.UnclaimedDraftCreateResponse, error)
+
+	// EditAndResendUnclaimedDraft Creates a new signature request from an embedded request that can be edited prior to being sent to
+	// the recipients. Parameter `test_mode` can be edited prior to request. Signers can be edited in embedded editor. Requester's email
+	// address will remain unchanged if `requester_email_address` parameter is not set.  **NOTE:** Embedded unclaimed drafts can only be
+	// accessed in embedded iFrames whereas normal drafts can be used and accessed on Dropbox Sign.
+	// Parameters:
+	//   - signatureRequestId The id of the SignatureRequest to edit and resend.
+	EditAndResendUnclaimedDraft(ctx context.Context, signatureRequestId string, req model.UnclaimedDraftEditAndResendRequest) (*model.UnclaimedDraftCreateResponse, error)
+
+	// GetTemplate Returns the Template specified by the `template_id` parameter.
+	// Parameters:
+	//   - templateId The id of the Template to retrieve.
+	GetTemplate(ctx context.Context, templateId string) (*model.TemplateGetResponse, error)
+
+	// ListTemplates Returns a list of the Templates that are accessible by you.  Take a look at our search guide to learn more about
+	// querying templates.
+	ListTemplates(ctx context.Context, req model.ListTemplatesRequest) (*model.TemplateListResponse, error)
+
+	// ListTemplatesPager returns a Pager over all the Templates matching req, fetching the pages of ListTemplates as
+	// needed.  req.Page is the first page fetched unless opts.StartPage is set.
+	ListTemplatesPager(ctx context.Context, req model.ListTemplatesRequest, opts PagerOptions) *Pager[model.TemplateResponse]
+
+	// DeleteTemplate Completely deletes the template specified from the account.
+	// Parameters:
+	//   - templateId The id of the Template to delete.
+	DeleteTemplate(ctx context.Context, templateId string) error
+
+	// AddUserToTemplate Gives the specified Account access to the specified Template. The specified Account must be a part of your Team.
+	// Parameters:
+	//   - templateId The id of the Template to give the Account access to.
+	AddUserToTemplate(ctx context.Context, templateId string, req model.TemplateAddUserRequest) (*model.TemplateGetResponse, error)
+
+	// RemoveUserFromTemplate Removes the specified Account's access to the specified Template.
+	// Parameters:
+	//   - templateId The id of the Template to remove the Account's access to.
+	RemoveUserFromTemplate(ctx context.Context, templateId string, req model.TemplateRemoveUserRequest) (*model.TemplateGetResponse, error)
+
+	// TemplateFiles Obtain a copy of the current documents specified by the `template_id` parameter.  Returns a PDF or ZIP file.
+	// If the files are currently being prepared, a status code of `409` will be returned instead, unless the client is
+	// configured to wait for them using [WithFilesWaitPolicy].
+	// Parameters:
+	//   - templateId The id of the template files to retrieve.
+	//   - fileType Set to "pdf" for a single merged document or "zip" for a collection of individual documents.
+	TemplateFiles(ctx context.Context, templateId, fileType string) ([]byte, error)
+
+	// TemplateFilesStream is like TemplateFiles, but returns the contents as a stream instead of reading them into memory.
+	// The caller must close the returned FileDownload.
+	TemplateFilesStream(ctx context.Context, templateId, fileType string) (*FileDownload, error)
+
+	// TemplateFilesAsDataUri Obtain a copy of the current documents specified by the `template_id` parameter.  Returns a JSON
+	// object with a `data_uri` representing the base64 encoded file (PDFs only).  If the files are currently being prepared, a
+	// status code of `409` will be returned instead, unless the client is configured to wait for them using [WithFilesWaitPolicy].
+	// Parameters:
+	//   - templateId The id of the template files to retrieve.
+	TemplateFilesAsDataUri(ctx context.Context, templateId string) (*model.FileResponseDataUri, error)
+
+	// TemplateFilesAsFileUrl Obtain a copy of the current documents specified by the `template_id` parameter.  Returns a JSON
+	// object with a url to the file (PDFs only).  If the files are currently being prepared, a status code of `409` will be
+	// returned instead, unless the client is configured to wait for them using [WithFilesWaitPolicy].
+	// Parameters:
+	//   - templateId The id of the template files to retrieve.
+	//   - forceDownload Whether the url returned causes the file to be downloaded (true) or rendered in the browser (false).
+	TemplateFilesAsFileUrl(ctx context.Context, templateId string, forceDownload bool) (*model.FileResponse, error)
+
+	// UpdateTemplateFiles Overlays a new file with the overlay of an existing template. The new file(s) must: 1. have the same or higher
+	// page count 2. the same orientation as the file(s) being replaced.  This will not overwrite or in any way affect the existing
+	// template. Both the existing template and new template will be available for use after executing this endpoint. Also note that
+	// this will decrement your template quota.
+	// Parameters:
+	//   - templateId The id of the Template whose files to update.
+	UpdateTemplateFiles(ctx context.Context, templateId string, req model.TemplateUpdateFilesRequest) (*model.TemplateUpdateFilesResponse, error)
+
+	// CreateTemplate Creates a template that can then be used.
+	CreateTemplate(ctx context.Context, req model.TemplateCreateRequest) (*model.TemplateCreateResponse, error)
+
+	// CreateEmbeddedTemplateDraft The first step in an embedded template workflow. Creates a draft template that can then be further
+	// set up in the template 'edit' stage.
+	CreateEmbeddedTemplateDraft(ctx context.Context, req model.TemplateCreateEmbeddedDraftRequest) (*model.TemplateCreateEmbeddedDraftResponse, error)
+
+	// RateLimit returns the rate limit as last reported by the API, or the zero value if the client was not configured
+	// using [WithRateLimiter].
+	RateLimit() RateLimit
 }
 
 // Assert that *Client implements API
 var _ API = (*Client)(nil)
 
 type Client struct {
-	httpClient *http.Client                  // A custom *http.Client to use, otherwise use http.DefaultClient
-	signer     func(req *http.Request) error // signer adds authentication header(s) to the request, returning an error if it can't
-	baseURL    string                        // Base URL to which to append endpoint paths
+	httpClient *http.Client     // A custom *http.Client to use, otherwise use http.DefaultClient
+	auth       Authenticator    // Adds credentials to the requests, nil to send them unauthenticated
+	baseURL    string           // Base URL to which to append endpoint paths
+	filesWait  *FilesWaitPolicy // How to wait for files being prepared, nil to fail immediately
+	retry      *RetryPolicy     // How to retry failed requests, nil to not retry
+	limiter    *rateLimiter     // Throttles requests to the API rate limit, nil to not throttle
+	noValidate bool             // Whether to skip validating requests before sending them
 }
 
 // NewClient creates a new Hellosign API client with optional configuration options.
@@ -70,22 +277,29 @@ func WithHTTPClient(hc *http.Client) Option {
 //
 // [API Settings page]: https://app.hellosign.com/home/myAccount#api
 func WithApiKey(key string) Option {
-	return func(c *Client) {
-		c.signer = func(req *http.Request) error {
-			req.SetBasicAuth(key, "")
-			return nil
-		}
-	}
+	return WithAuthenticator(APIKeyAuthenticator(key))
 }
 
 // WithAccessToken configures the client to authenticate using an access token (issued during
-// an OAuth flow) to send API requests on behalf of the user that granted authorization.
+// an OAuth flow) to send API requests on behalf of the user that granted authorization.  The token is used as is, use
+// [WithTokenSource] to refresh it when it expires.
 func WithAccessToken(token string) Option {
+	return WithAuthenticator(AccessTokenAuthenticator(token))
+}
+
+// WithAuthenticator configures the client to authenticate requests using the Authenticator a, such as one fetching
+// rotating API keys from a secrets manager.  It can be overridden for some requests with [WithRequestAuthenticator].
+func WithAuthenticator(a Authenticator) Option {
+	return func(c *Client) {
+		c.auth = a
+	}
+}
+
+// WithoutValidation disables the validation of requests before they are sent, leaving it to the API.  By default
+// requests are checked with their Validate method, and a *model.ValidationError is returned if they are invalid.
+func WithoutValidation() Option {
 	return func(c *Client) {
-		c.signer = func(req *http.Request) error {
-			req.Header.Set("Authorization", "Bearer "+token)
-			return nil
-		}
+		c.noValidate = true
 	}
 }
 
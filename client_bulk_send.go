@@ -0,0 +1,105 @@
+package hellosign
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sean-rn/hellosign-sdk/model"
+)
+
+// BulkSendWithTemplate Creates BulkSendJob which sends up to 250 SignatureRequests in bulk based off of the provided Template(s)
+// specified with the `template_ids` parameter.  **NOTE:** Only available for Premium plan and higher.
+func (c *Client) BulkSendWithTemplate(ctx context.Context, r model.SignatureRequestBulkSendWithTemplateRequest) (*model.BulkSendJobSendResponse, error) {
+	furl := fmt.Sprintf("%s/v3/signature_request/bulk_send_with_template", c.baseURL)
+	req, err := c.newRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.BulkSendJobSendResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// BulkCreateEmbeddedWithTemplate Creates BulkSendJob which sends up to 250 SignatureRequests in bulk based off of the provided
+// Template(s) specified with the `template_ids` parameter to be signed in an embedded iFrame. These embedded signature requests
+// can only be signed in embedded iFrames whereas normal signature requests can only be signed on Dropbox Sign.  **NOTE:** Only
+// available for Enterprise plan.
+func (c *Client) BulkCreateEmbeddedWithTemplate(ctx context.Context, r model.SignatureRequestBulkCreateEmbeddedWithTemplateRequest) (*model.BulkSendJobSendResponse, error) {
+	furl := fmt.Sprintf("%s/v3/signature_request/bulk_create_embedded_with_template", c.baseURL)
+	req, err := c.newRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.BulkSendJobSendResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// GetBulkSendJob Returns the status of the BulkSendJob and its SignatureRequests specified by the `bulk_send_job_id` parameter.
+// Parameters:
+//   - bulkSendJobId The id of the BulkSendJob to retrieve.
+//   - r The page of the SignatureRequests of the BulkSendJob to return.
+func (c *Client) GetBulkSendJob(ctx context.Context, bulkSendJobId string, r model.GetBulkSendJobRequest) (*model.BulkSendJobGetResponse, error) {
+	if err := c.validate(r); err != nil {
+		return nil, err
+	}
+	furl := listURL(fmt.Sprintf("%s/v3/bulk_send_job/%s", c.baseURL, url.PathEscape(bulkSendJobId)), "", r.Page, r.PageSize, "")
+	req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.BulkSendJobGetResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// BulkSendJobSignatureRequestsPager returns a Pager over all the SignatureRequests of a BulkSendJob, fetching the pages
+// of GetBulkSendJob as needed.  r.Page is the first page fetched unless opts.StartPage is set.
+func (c *Client) BulkSendJobSignatureRequestsPager(ctx context.Context, bulkSendJobId string, r model.GetBulkSendJobRequest, opts PagerOptions) *Pager[model.SignatureRequestResponse] {
+	if opts.StartPage == 0 {
+		opts.StartPage = r.Page
+	}
+	return NewPager(ctx, func(ctx context.Context, page int) ([]model.SignatureRequestResponse, model.ListInfoResponse, error) {
+		pageReq := r
+		pageReq.Page = page
+		resp, err := c.GetBulkSendJob(ctx, bulkSendJobId, pageReq)
+		if err != nil {
+			return nil, model.ListInfoResponse{}, err
+		}
+		return resp.SignatureRequests, resp.ListInfo, nil
+	}, opts)
+}
+
+// ListBulkSendJobs Returns a list of BulkSendJob that you can access.
+func (c *Client) ListBulkSendJobs(ctx context.Context, r model.ListBulkSendJobsRequest) (*model.BulkSendJobListResponse, error) {
+	if err := c.validate(r); err != nil {
+		return nil, err
+	}
+	furl := listURL(fmt.Sprintf("%s/v3/bulk_send_job/list", c.baseURL), "", r.Page, r.PageSize, "")
+	req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.BulkSendJobListResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// ListBulkSendJobsPager returns a Pager over all the BulkSendJobs, fetching the pages of ListBulkSendJobs as needed.
+// r.Page is the first page fetched unless opts.StartPage is set.
+func (c *Client) ListBulkSendJobsPager(ctx context.Context, r model.ListBulkSendJobsRequest, opts PagerOptions) *Pager[model.BulkSendJobResponse] {
+	if opts.StartPage == 0 {
+		opts.StartPage = r.Page
+	}
+	return NewPager(ctx, func(ctx context.Context, page int) ([]model.BulkSendJobResponse, model.ListInfoResponse, error) {
+		pageReq := r
+		pageReq.Page = page
+		resp, err := c.ListBulkSendJobs(ctx, pageReq)
+		if err != nil {
+			return nil, model.ListInfoResponse{}, err
+		}
+		return resp.BulkSendJobs, resp.ListInfo, nil
+	}, opts)
+}
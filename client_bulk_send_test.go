@@ -0,0 +1,149 @@
+package hellosign_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sean-rn/hellosign-sdk"
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bulkSendJobResponse = `{"bulk_send_job": {"bulk_send_job_id": "6e683bc0369ba3d5b6f43c2c22a8031dbf6bd174", "total": 2, "is_creator": true, "created_at": 1532640962}}`
+
+func TestBulkSendWithTemplate(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/signature_request/bulk_send_with_template", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(bulkSendJobResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	resp, err := client.BulkSendWithTemplate(context.Background(), model.SignatureRequestBulkSendWithTemplateRequest{
+		TemplateIds: []string{"c26b8a16784a872da37ea946b9ddec7c1e11dff6"},
+		SignerList: []model.SubBulkSignerList{
+			{
+				Signers:      []model.SubSignatureRequestTemplateSigner{{Role: "Employee", Name: "George", EmailAddress: "george@example.org"}},
+				CustomFields: []model.SubBulkSignerListCustomField{{Name: "company", Value: "ABC Corp"}},
+			},
+			{
+				Signers: []model.SubSignatureRequestTemplateSigner{{Role: "Employee", Name: "Mary", EmailAddress: "mary@example.org"}},
+			},
+		},
+		TestMode: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "6e683bc0369ba3d5b6f43c2c22a8031dbf6bd174", resp.BulkSendJob.BulkSendJobId)
+	assert.Equal(t, 2, resp.BulkSendJob.Total)
+	assert.Equal(t, int64(1532640962), resp.BulkSendJob.CreatedAt.Unix())
+
+	signerList := body["signer_list"].([]any)
+	require.Len(t, signerList, 2)
+	assert.Equal(t, "ABC Corp", signerList[0].(map[string]any)["custom_fields"].([]any)[0].(map[string]any)["value"])
+	assert.Equal(t, "mary@example.org", signerList[1].(map[string]any)["signers"].([]any)[0].(map[string]any)["email_address"])
+}
+
+func TestBulkCreateEmbeddedWithTemplateSignerFile(t *testing.T) {
+	var form map[string][]string
+	var signerFile string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/signature_request/bulk_create_embedded_with_template", r.URL.Path)
+		if !assert.NoError(t, r.ParseMultipartForm(1<<20)) {
+			http.Error(w, "bad form", http.StatusBadRequest)
+			return
+		}
+		form = r.MultipartForm.Value
+		f, err := r.MultipartForm.File["signer_file"][0].Open()
+		if !assert.NoError(t, err) {
+			http.Error(w, "bad file", http.StatusBadRequest)
+			return
+		}
+		data, err := io.ReadAll(f)
+		assert.NoError(t, err)
+		signerFile = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(bulkSendJobResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	csv := "Employee_name,Employee_email_address\nGeorge,george@example.org\n"
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	_, err := client.BulkCreateEmbeddedWithTemplate(context.Background(), model.SignatureRequestBulkCreateEmbeddedWithTemplateRequest{
+		TemplateIds: []string{"c26b8a16784a872da37ea946b9ddec7c1e11dff6"},
+		ClientId:    "1a659d9ad95bccd307ecad78d72192f8",
+		SignerFile:  model.FileFromBytes("signers.csv", []byte(csv)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1a659d9ad95bccd307ecad78d72192f8"}, form["client_id"])
+	assert.Equal(t, []string{"c26b8a16784a872da37ea946b9ddec7c1e11dff6"}, form["template_ids[0]"])
+	assert.Equal(t, csv, signerFile)
+
+	_, err = client.BulkCreateEmbeddedWithTemplate(context.Background(), model.SignatureRequestBulkCreateEmbeddedWithTemplateRequest{
+		TemplateIds: []string{"c26b8a16784a872da37ea946b9ddec7c1e11dff6"},
+		ClientId:    "1a659d9ad95bccd307ecad78d72192f8",
+	})
+	var validationErr *model.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "signer_list", validationErr.Errors[0].Field)
+}
+
+func TestBulkSendJobs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/v3/bulk_send_job/list":
+			json.NewEncoder(w).Encode(model.BulkSendJobListResponse{
+				BulkSendJobs: []model.BulkSendJobResponse{{BulkSendJobId: "job-" + strconv.Itoa(page)}},
+				ListInfo:     model.ListInfoResponse{NumPages: 2, NumResults: 2, Page: page, PageSize: 1},
+			})
+		case strings.HasPrefix(r.URL.Path, "/v3/bulk_send_job/"):
+			assert.Equal(t, "/v3/bulk_send_job/6e683bc0369ba3d5b6f43c2c22a8031dbf6bd174", r.URL.Path)
+			json.NewEncoder(w).Encode(model.BulkSendJobGetResponse{
+				BulkSendJob: model.BulkSendJobResponse{BulkSendJobId: "6e683bc0369ba3d5b6f43c2c22a8031dbf6bd174", Total: 3},
+				SignatureRequests: []model.SignatureRequestResponse{
+					{SignatureRequestId: "request-" + strconv.Itoa(page), BulkSendJobId: "6e683bc0369ba3d5b6f43c2c22a8031dbf6bd174"},
+				},
+				ListInfo: model.ListInfoResponse{NumPages: 3, NumResults: 3, Page: page, PageSize: 1},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+
+	job, err := client.GetBulkSendJob(ctx, "6e683bc0369ba3d5b6f43c2c22a8031dbf6bd174", model.GetBulkSendJobRequest{Page: 2, PageSize: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 3, job.BulkSendJob.Total)
+	assert.Equal(t, "request-2", job.SignatureRequests[0].SignatureRequestId)
+
+	var requestIds []string
+	requests := client.BulkSendJobSignatureRequestsPager(ctx, "6e683bc0369ba3d5b6f43c2c22a8031dbf6bd174", model.GetBulkSendJobRequest{PageSize: 1}, hellosign.PagerOptions{})
+	for requests.Next() {
+		requestIds = append(requestIds, requests.Item().SignatureRequestId)
+	}
+	require.NoError(t, requests.Err())
+	assert.Equal(t, []string{"request-1", "request-2", "request-3"}, requestIds)
+
+	var jobIds []string
+	jobs := client.ListBulkSendJobsPager(ctx, model.ListBulkSendJobsRequest{PageSize: 1}, hellosign.PagerOptions{})
+	for jobs.Next() {
+		jobIds = append(jobIds, jobs.Item().BulkSendJobId)
+	}
+	require.NoError(t, jobs.Err())
+	assert.Equal(t, []string{"job-1", "job-2"}, jobIds)
+}
@@ -8,12 +8,14 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/sean-rn/hellosign-sdk/model"
 )
 
 // DownloadFiles Obtain a copy of the current documents specified by the `signature_request_id` parameter.
-// Returns a PDF or ZIP file. If the files are currently being prepared, a status code of `409` will be returned instead.
+// Returns a PDF or ZIP file. If the files are currently being prepared, a status code of `409` will be returned instead,
+// unless the client is configured to wait for them using [WithFilesWaitPolicy].
 // Parameters:
 //   - signatureRequestId The id of the SignatureRequest to retrieve.
 //   - fileType Set to "pdf" for a single merged document or "zip" for a collection of individual documents.
@@ -23,13 +25,81 @@ func (c *Client) DownloadFiles(ctx context.Context, signatureRequestId, fileType
 		furl += "?file_type=" + url.QueryEscape(fileType)
 	}
 
-	req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+	var data []byte
+	err := c.waitForFiles(ctx, func() error {
+		req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+		if err != nil {
+			return err
+		}
+		return c.doRequest(req, &data)
+	})
+	return data, err
+}
+
+// DownloadFilesStream is like DownloadFiles, but returns the contents as a stream instead of reading them into memory.
+// The caller must close the returned FileDownload.
+func (c *Client) DownloadFilesStream(ctx context.Context, signatureRequestId, fileType string) (*FileDownload, error) {
+	furl := fmt.Sprintf("%s/v3/signature_request/files/%s", c.baseURL, url.PathEscape(signatureRequestId))
+	if fileType != "" {
+		furl += "?file_type=" + url.QueryEscape(fileType)
+	}
+
+	var resp *http.Response
+	err := c.waitForFiles(ctx, func() error {
+		req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+		if err != nil {
+			return err
+		}
+		resp, err = c.do(req)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	var data []byte
-	err = c.doRequest(req, &data)
-	return data, err
+	return newFileDownload(resp), nil
+}
+
+// FilesAsDataUri Obtain a copy of the current documents specified by the `signature_request_id` parameter.
+// Returns a JSON object with a `data_uri` representing the base64 encoded file (PDFs only). If the files are currently
+// being prepared, a status code of `409` will be returned instead, unless the client is configured to wait for them
+// using [WithFilesWaitPolicy].
+// Parameters:
+//   - signatureRequestId The id of the SignatureRequest to retrieve.
+func (c *Client) FilesAsDataUri(ctx context.Context, signatureRequestId string) (*model.FileResponseDataUri, error) {
+	furl := fmt.Sprintf("%s/v3/signature_request/files_as_data_uri/%s", c.baseURL, url.PathEscape(signatureRequestId))
+	var resp model.FileResponseDataUri
+	err := c.waitForFiles(ctx, func() error {
+		req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+		if err != nil {
+			return err
+		}
+		return c.doRequest(req, &resp)
+	})
+	return &resp, err
+}
+
+// FilesAsFileUrl Obtain a copy of the current documents specified by the `signature_request_id` parameter.
+// Returns a JSON object with a url to the file (PDFs only). If the files are currently being prepared, a status code
+// of `409` will be returned instead, unless the client is configured to wait for them using [WithFilesWaitPolicy].
+// Parameters:
+//   - signatureRequestId The id of the SignatureRequest to retrieve.
+//   - forceDownload Whether the url returned causes the file to be downloaded (true) or rendered in the browser (false).
+func (c *Client) FilesAsFileUrl(ctx context.Context, signatureRequestId string, forceDownload bool) (*model.FileResponse, error) {
+	furl := fmt.Sprintf("%s/v3/signature_request/files_as_file_url/%s", c.baseURL, url.PathEscape(signatureRequestId))
+	if forceDownload {
+		furl += "?force_download=1"
+	} else {
+		furl += "?force_download=0"
+	}
+	var resp model.FileResponse
+	err := c.waitForFiles(ctx, func() error {
+		req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+		if err != nil {
+			return err
+		}
+		return c.doRequest(req, &resp)
+	})
+	return &resp, err
 }
 
 // Create Embedded Signature Request with Template
@@ -38,21 +108,183 @@ func (c *Client) DownloadFiles(ctx context.Context, signatureRequestId, fileType
 // can only be signed on Dropbox Sign.
 func (c *Client) CreateEmbeddedWithTemplate(ctx context.Context, r model.CreateEmbeddedWithTemplateRequest) (*model.SignatureRequestGetResponse, error) {
 	furl := fmt.Sprintf("%s/v3/signature_request/create_embedded_with_template", c.baseURL)
+	req, err := c.newRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.SignatureRequestGetResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// Create Embedded Signature Request
+// Creates a new SignatureRequest with the submitted documents to be signed in an embedded iFrame. If form_fields_per_document is
+// not specified, a signature page will be affixed where all signers will be required to add their signature, signifying their
+// agreement to all contained documents. Note that embedded signature requests can only be signed in embedded iFrames whereas
+// normal signature requests can only be signed on Dropbox Sign.
+func (c *Client) CreateEmbedded(ctx context.Context, r model.CreateEmbeddedRequest) (*model.SignatureRequestGetResponse, error) {
+	furl := fmt.Sprintf("%s/v3/signature_request/create_embedded", c.baseURL)
+	req, err := c.newRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.SignatureRequestGetResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// SendSignatureRequest Creates and sends a new SignatureRequest with the submitted documents. If `form_fields_per_document` is not
+// specified, a signature page will be affixed where all signers will be required to add their signature, signifying their agreement
+// to all contained documents.
+func (c *Client) SendSignatureRequest(ctx context.Context, r model.SendSignatureRequestRequest) (*model.SignatureRequestGetResponse, error) {
+	furl := fmt.Sprintf("%s/v3/signature_request/send", c.baseURL)
+	req, err := c.newRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.SignatureRequestGetResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// SendWithTemplate Creates and sends a new SignatureRequest based off of the Template(s) specified with the `template_ids`
+// parameter.
+func (c *Client) SendWithTemplate(ctx context.Context, r model.SendWithTemplateRequest) (*model.SignatureRequestGetResponse, error) {
+	furl := fmt.Sprintf("%s/v3/signature_request/send_with_template", c.baseURL)
+	req, err := c.newRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.SignatureRequestGetResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// GetSignatureRequest Returns the status of the SignatureRequest specified by the `signature_request_id` parameter.
+// Parameters:
+//   - signatureRequestId The id of the SignatureRequest to retrieve.
+func (c *Client) GetSignatureRequest(ctx context.Context, signatureRequestId string) (*model.SignatureRequestGetResponse, error) {
+	furl := fmt.Sprintf("%s/v3/signature_request/%s", c.baseURL, url.PathEscape(signatureRequestId))
+	req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.SignatureRequestGetResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// RemindSignatureRequest Sends an email to the signer reminding them to sign the signature request. You cannot send a reminder
+// within 1 hour of the last reminder that was sent. This includes manual AND automatic reminders.  Fails with
+// [ErrEmbeddedSignatureRequest] for embedded signature requests.
+// Parameters:
+//   - signatureRequestId The id of the SignatureRequest to send a reminder for.
+func (c *Client) RemindSignatureRequest(ctx context.Context, signatureRequestId string, r model.RemindSignatureRequestRequest) (*model.SignatureRequestGetResponse, error) {
+	furl := fmt.Sprintf("%s/v3/signature_request/remind/%s", c.baseURL, url.PathEscape(signatureRequestId))
+	req, err := c.newJSONRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.SignatureRequestGetResponse
+	err = signatureRequestError(c.doRequest(req, &resp))
+	return &resp, err
+}
+
+// UpdateSignatureRequest Updates the email address and/or the name for a given signer on a signature request, or the
+// expiration date of the signature request.  The email address cannot be updated for embedded signature requests.
+// Parameters:
+//   - signatureRequestId The id of the SignatureRequest to update.
+func (c *Client) UpdateSignatureRequest(ctx context.Context, signatureRequestId string, r model.UpdateSignatureRequestRequest) (*model.SignatureRequestGetResponse, error) {
+	furl := fmt.Sprintf("%s/v3/signature_request/update/%s", c.baseURL, url.PathEscape(signatureRequestId))
 	req, err := c.newJSONRequest(ctx, http.MethodPost, furl, r)
 	if err != nil {
 		return nil, err
 	}
 	var resp model.SignatureRequestGetResponse
+	err = signatureRequestError(c.doRequest(req, &resp))
+	return &resp, err
+}
+
+// CancelSignatureRequest Cancels an incomplete signature request. This action is **not reversible**.  Fails with
+// [ErrSignatureRequestComplete] for complete signature requests.
+// Parameters:
+//   - signatureRequestId The id of the incomplete SignatureRequest to cancel.
+func (c *Client) CancelSignatureRequest(ctx context.Context, signatureRequestId string) error {
+	furl := fmt.Sprintf("%s/v3/signature_request/cancel/%s", c.baseURL, url.PathEscape(signatureRequestId))
+	req, err := c.newJSONRequest(ctx, http.MethodPost, furl, nil)
+	if err != nil {
+		return err
+	}
+	return signatureRequestError(c.doRequest(req, nil))
+}
+
+// RemoveSignatureRequest Removes your access to a completed signature request. This action is **not reversible**.
+// Parameters:
+//   - signatureRequestId The id of the SignatureRequest to remove.
+func (c *Client) RemoveSignatureRequest(ctx context.Context, signatureRequestId string) error {
+	furl := fmt.Sprintf("%s/v3/signature_request/remove/%s", c.baseURL, url.PathEscape(signatureRequestId))
+	req, err := c.newJSONRequest(ctx, http.MethodPost, furl, nil)
+	if err != nil {
+		return err
+	}
+	return signatureRequestError(c.doRequest(req, nil))
+}
+
+// ReleaseHold Releases a held SignatureRequest that was claimed and prepared from an UnclaimedDraft.  Fails with
+// [ErrSignatureRequestNotOnHold] if the signature request is not on hold.
+// Parameters:
+//   - signatureRequestId The id of the SignatureRequest to release.
+func (c *Client) ReleaseHold(ctx context.Context, signatureRequestId string) (*model.SignatureRequestGetResponse, error) {
+	furl := fmt.Sprintf("%s/v3/signature_request/release_hold/%s", c.baseURL, url.PathEscape(signatureRequestId))
+	req, err := c.newJSONRequest(ctx, http.MethodPost, furl, nil)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.SignatureRequestGetResponse
+	err = signatureRequestError(c.doRequest(req, &resp))
+	return &resp, err
+}
+
+// ListSignatureRequests Returns a list of SignatureRequests that you can access. This includes SignatureRequests you have sent as
+// well as received, but not ones that you have been CCed on.  Take a look at our search guide to learn more about querying
+// signature requests.
+func (c *Client) ListSignatureRequests(ctx context.Context, r model.ListSignatureRequestsRequest) (*model.SignatureRequestListResponse, error) {
+	if err := c.validate(r); err != nil {
+		return nil, err
+	}
+	furl := listURL(fmt.Sprintf("%s/v3/signature_request/list", c.baseURL), r.AccountId, r.Page, r.PageSize, r.Query)
+	req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.SignatureRequestListResponse
 	err = c.doRequest(req, &resp)
 	return &resp, err
 }
 
+// ListSignatureRequestsPager returns a Pager over all the SignatureRequests matching req, fetching the pages of
+// ListSignatureRequests as needed.  req.Page is the first page fetched unless opts.StartPage is set.
+func (c *Client) ListSignatureRequestsPager(ctx context.Context, r model.ListSignatureRequestsRequest, opts PagerOptions) *Pager[model.SignatureRequestResponse] {
+	if opts.StartPage == 0 {
+		opts.StartPage = r.Page
+	}
+	return NewPager(ctx, func(ctx context.Context, page int) ([]model.SignatureRequestResponse, model.ListInfoResponse, error) {
+		pageReq := r
+		pageReq.Page = page
+		resp, err := c.ListSignatureRequests(ctx, pageReq)
+		if err != nil {
+			return nil, model.ListInfoResponse{}, err
+		}
+		return resp.SignatureRequests, resp.ListInfo, nil
+	}, opts)
+}
+
 // Retrieves an embedded object containing a signature url that can be opened in an iFrame.
 // Parameters:
 //   - signatureId The id of the signature to get a signature url for.
 func (c *Client) GetEmbeddedSignUrl(ctx context.Context, signatureId string) (*model.EmbeddedSignUrlResponse, error) {
 	furl := fmt.Sprintf("%s/v3/embedded/sign_url/%s", c.baseURL, url.PathEscape(signatureId))
-	req, err := c.newJSONRequest(ctx, http.MethodPost, furl, nil)
+	req, err := c.newJSONRequest(withRetrySafe(ctx), http.MethodPost, furl, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -61,10 +293,51 @@ func (c *Client) GetEmbeddedSignUrl(ctx context.Context, signatureId string) (*m
 	return &resp, err
 }
 
-// newJSONRequest creates a signed request with an optional JSON request body
+// Retrieves an embedded object containing a template url that can be opened in an iFrame. Note that only templates created via the
+// embedded template process are available to be edited with this endpoint.
+// Parameters:
+//   - templateId The id of the template to edit.
+//   - opts Options of the editor, and changes to apply to the template.
+func (c *Client) GetEmbeddedEditUrl(ctx context.Context, templateId string, opts model.EmbeddedEditUrlRequest) (*model.EmbeddedEditUrlResponse, error) {
+	furl := fmt.Sprintf("%s/v3/embedded/edit_url/%s", c.baseURL, url.PathEscape(templateId))
+	req, err := c.newJSONRequest(ctx, http.MethodPost, furl, opts)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.EmbeddedEditUrlResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// listURL appends the query parameters shared by the list endpoints to furl, omitting those left unset
+func listURL(furl, accountId string, page, pageSize int, q string) string {
+	query := url.Values{}
+	if accountId != "" {
+		query.Set("account_id", accountId)
+	}
+	if page > 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if pageSize > 0 {
+		query.Set("page_size", strconv.Itoa(pageSize))
+	}
+	if q != "" {
+		query.Set("query", q)
+	}
+	if len(query) > 0 {
+		furl += "?" + query.Encode()
+	}
+	return furl
+}
+
+// newJSONRequest creates a request with an optional JSON request body, after validating it.  Credentials are added
+// each time it is sent, see sendOnce.
 func (c *Client) newJSONRequest(ctx context.Context, method, url string, body any) (*http.Request, error) {
 	var bodyReader io.Reader
 	if body != nil {
+		if err := c.validate(body); err != nil {
+			return nil, err
+		}
 		jsonStr, err := json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("marshalling body: %w", err)
@@ -79,31 +352,30 @@ func (c *Client) newJSONRequest(ctx context.Context, method, url string, body an
 	if body != nil {
 		req.Header.Add("Content-Type", "application/json")
 	}
+	return req, nil
+}
 
-	if c.signer != nil {
-		if err := c.signer(req); err != nil {
-			return nil, fmt.Errorf("signing request: %w", err)
-		}
+// validatable is implemented by requests which can check themselves before being sent
+type validatable interface {
+	Validate() error
+}
+
+// validate calls the Validate method of req, if it has one and the client has not been configured not to
+func (c *Client) validate(req any) error {
+	if v, ok := req.(validatable); ok && !c.noValidate {
+		return v.Validate()
 	}
-	return req, nil
+	return nil
 }
 
 // Do sends an HTTP request and optionally parses the response into a target.
 func (c *Client) doRequest(req *http.Request, target any) error {
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		respBody, err := io.ReadAll(io.LimitReader(resp.Body, 4096))
-		if err != nil {
-			return fmt.Errorf("error reading error response: %s: %w", resp.Status, err)
-		}
-		return fmt.Errorf("request returned %s: %s", resp.Status, respBody)
-	}
-
 	switch t := target.(type) {
 	case nil:
 		return nil // Do nothing, no target given
@@ -115,3 +387,18 @@ func (c *Client) doRequest(req *http.Request, target any) error {
 		return json.NewDecoder(resp.Body).Decode(target)
 	}
 }
+
+// do sends an HTTP request, returning the response if it has a 2xx status code or an *APIError otherwise.
+// The caller must close the body of the returned response.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		return nil, newAPIError(resp)
+	}
+	return resp, nil
+}
@@ -0,0 +1,227 @@
+package hellosign
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sean-rn/hellosign-sdk/model"
+)
+
+// GetTemplate Returns the Template specified by the `template_id` parameter.
+// Parameters:
+//   - templateId The id of the Template to retrieve.
+func (c *Client) GetTemplate(ctx context.Context, templateId string) (*model.TemplateGetResponse, error) {
+	furl := fmt.Sprintf("%s/v3/template/%s", c.baseURL, url.PathEscape(templateId))
+	req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.TemplateGetResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// ListTemplates Returns a list of the Templates that are accessible by you.  Take a look at our search guide to learn more about
+// querying templates.
+func (c *Client) ListTemplates(ctx context.Context, r model.ListTemplatesRequest) (*model.TemplateListResponse, error) {
+	if err := c.validate(r); err != nil {
+		return nil, err
+	}
+	furl := listURL(fmt.Sprintf("%s/v3/template/list", c.baseURL), r.AccountId, r.Page, r.PageSize, r.Query)
+	req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.TemplateListResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// ListTemplatesPager returns a Pager over all the Templates matching req, fetching the pages of ListTemplates as
+// needed.  req.Page is the first page fetched unless opts.StartPage is set.
+func (c *Client) ListTemplatesPager(ctx context.Context, r model.ListTemplatesRequest, opts PagerOptions) *Pager[model.TemplateResponse] {
+	if opts.StartPage == 0 {
+		opts.StartPage = r.Page
+	}
+	return NewPager(ctx, func(ctx context.Context, page int) ([]model.TemplateResponse, model.ListInfoResponse, error) {
+		pageReq := r
+		pageReq.Page = page
+		resp, err := c.ListTemplates(ctx, pageReq)
+		if err != nil {
+			return nil, model.ListInfoResponse{}, err
+		}
+		return resp.Templates, resp.ListInfo, nil
+	}, opts)
+}
+
+// DeleteTemplate Completely deletes the template specified from the account.
+// Parameters:
+//   - templateId The id of the Template to delete.
+func (c *Client) DeleteTemplate(ctx context.Context, templateId string) error {
+	furl := fmt.Sprintf("%s/v3/template/delete/%s", c.baseURL, url.PathEscape(templateId))
+	req, err := c.newJSONRequest(ctx, http.MethodPost, furl, nil)
+	if err != nil {
+		return err
+	}
+	return c.doRequest(req, nil)
+}
+
+// AddUserToTemplate Gives the specified Account access to the specified Template. The specified Account must be a part of your Team.
+// Parameters:
+//   - templateId The id of the Template to give the Account access to.
+func (c *Client) AddUserToTemplate(ctx context.Context, templateId string, r model.TemplateAddUserRequest) (*model.TemplateGetResponse, error) {
+	furl := fmt.Sprintf("%s/v3/template/add_user/%s", c.baseURL, url.PathEscape(templateId))
+	req, err := c.newJSONRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.TemplateGetResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// RemoveUserFromTemplate Removes the specified Account's access to the specified Template.
+// Parameters:
+//   - templateId The id of the Template to remove the Account's access to.
+func (c *Client) RemoveUserFromTemplate(ctx context.Context, templateId string, r model.TemplateRemoveUserRequest) (*model.TemplateGetResponse, error) {
+	furl := fmt.Sprintf("%s/v3/template/remove_user/%s", c.baseURL, url.PathEscape(templateId))
+	req, err := c.newJSONRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.TemplateGetResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// TemplateFiles Obtain a copy of the current documents specified by the `template_id` parameter.  Returns a PDF or ZIP file.
+// If the files are currently being prepared, a status code of `409` will be returned instead, unless the client is
+// configured to wait for them using [WithFilesWaitPolicy].
+// Parameters:
+//   - templateId The id of the template files to retrieve.
+//   - fileType Set to "pdf" for a single merged document or "zip" for a collection of individual documents.
+func (c *Client) TemplateFiles(ctx context.Context, templateId, fileType string) ([]byte, error) {
+	furl := fmt.Sprintf("%s/v3/template/files/%s", c.baseURL, url.PathEscape(templateId))
+	if fileType != "" {
+		furl += "?file_type=" + url.QueryEscape(fileType)
+	}
+
+	var data []byte
+	err := c.waitForFiles(ctx, func() error {
+		req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+		if err != nil {
+			return err
+		}
+		return c.doRequest(req, &data)
+	})
+	return data, err
+}
+
+// TemplateFilesStream is like TemplateFiles, but returns the contents as a stream instead of reading them into memory.
+// The caller must close the returned FileDownload.
+func (c *Client) TemplateFilesStream(ctx context.Context, templateId, fileType string) (*FileDownload, error) {
+	furl := fmt.Sprintf("%s/v3/template/files/%s", c.baseURL, url.PathEscape(templateId))
+	if fileType != "" {
+		furl += "?file_type=" + url.QueryEscape(fileType)
+	}
+
+	var resp *http.Response
+	err := c.waitForFiles(ctx, func() error {
+		req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+		if err != nil {
+			return err
+		}
+		resp, err = c.do(req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newFileDownload(resp), nil
+}
+
+// TemplateFilesAsDataUri Obtain a copy of the current documents specified by the `template_id` parameter.  Returns a JSON
+// object with a `data_uri` representing the base64 encoded file (PDFs only).  If the files are currently being prepared, a
+// status code of `409` will be returned instead, unless the client is configured to wait for them using [WithFilesWaitPolicy].
+// Parameters:
+//   - templateId The id of the template files to retrieve.
+func (c *Client) TemplateFilesAsDataUri(ctx context.Context, templateId string) (*model.FileResponseDataUri, error) {
+	furl := fmt.Sprintf("%s/v3/template/files_as_data_uri/%s", c.baseURL, url.PathEscape(templateId))
+	var resp model.FileResponseDataUri
+	err := c.waitForFiles(ctx, func() error {
+		req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+		if err != nil {
+			return err
+		}
+		return c.doRequest(req, &resp)
+	})
+	return &resp, err
+}
+
+// TemplateFilesAsFileUrl Obtain a copy of the current documents specified by the `template_id` parameter.  Returns a JSON
+// object with a url to the file (PDFs only).  If the files are currently being prepared, a status code of `409` will be
+// returned instead, unless the client is configured to wait for them using [WithFilesWaitPolicy].
+// Parameters:
+//   - templateId The id of the template files to retrieve.
+//   - forceDownload Whether the url returned causes the file to be downloaded (true) or rendered in the browser (false).
+func (c *Client) TemplateFilesAsFileUrl(ctx context.Context, templateId string, forceDownload bool) (*model.FileResponse, error) {
+	furl := fmt.Sprintf("%s/v3/template/files_as_file_url/%s", c.baseURL, url.PathEscape(templateId))
+	if forceDownload {
+		furl += "?force_download=1"
+	} else {
+		furl += "?force_download=0"
+	}
+	var resp model.FileResponse
+	err := c.waitForFiles(ctx, func() error {
+		req, err := c.newJSONRequest(ctx, http.MethodGet, furl, nil)
+		if err != nil {
+			return err
+		}
+		return c.doRequest(req, &resp)
+	})
+	return &resp, err
+}
+
+// UpdateTemplateFiles Overlays a new file with the overlay of an existing template. The new file(s) must: 1. have the same or higher
+// page count 2. the same orientation as the file(s) being replaced.  This will not overwrite or in any way affect the existing
+// template. Both the existing template and new template will be available for use after executing this endpoint. Also note that
+// this will decrement your template quota.
+// Parameters:
+//   - templateId The id of the Template whose files to update.
+func (c *Client) UpdateTemplateFiles(ctx context.Context, templateId string, r model.TemplateUpdateFilesRequest) (*model.TemplateUpdateFilesResponse, error) {
+	furl := fmt.Sprintf("%s/v3/template/update_files/%s", c.baseURL, url.PathEscape(templateId))
+	req, err := c.newRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.TemplateUpdateFilesResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// CreateTemplate Creates a template that can then be used.
+func (c *Client) CreateTemplate(ctx context.Context, r model.TemplateCreateRequest) (*model.TemplateCreateResponse, error) {
+	furl := fmt.Sprintf("%s/v3/template/create", c.baseURL)
+	req, err := c.newRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.TemplateCreateResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// CreateEmbeddedTemplateDraft The first step in an embedded template workflow. Creates a draft template that can then be further
+// set up in the template 'edit' stage.
+func (c *Client) CreateEmbeddedTemplateDraft(ctx context.Context, r model.TemplateCreateEmbeddedDraftRequest) (*model.TemplateCreateEmbeddedDraftResponse, error) {
+	furl := fmt.Sprintf("%s/v3/template/create_embedded_draft", c.baseURL)
+	req, err := c.newRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.TemplateCreateEmbeddedDraftResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
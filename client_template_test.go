@@ -0,0 +1,201 @@
+package hellosign_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/sean-rn/hellosign-sdk"
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTemplate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v3/template/f57db65d3f933b5316d398057a36176831451a35", r.URL.Path)
+		http.ServeFile(w, r, "testdata/get_template.resp.json")
+	}))
+	t.Cleanup(server.Close)
+
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	resp, err := client.GetTemplate(context.Background(), "f57db65d3f933b5316d398057a36176831451a35")
+	require.NoError(t, err)
+
+	template := resp.Template
+	assert.Equal(t, "Mutual NDA", template.Title)
+	require.Len(t, template.SignerRoles, 2)
+	assert.Equal(t, "Witness", template.SignerRoles[1].Name)
+	assert.Equal(t, 1, *template.SignerRoles[1].Order)
+	assert.Equal(t, "Manager", template.CCRoles[0].Name)
+	require.Len(t, template.Documents, 1)
+	assert.Equal(t, "Signature1", template.Documents[0].FormFields[0].Name)
+	assert.Equal(t, "Effective Date", template.Documents[0].CustomFields[0].Name)
+	assert.Equal(t, "me@hellosign.com", template.Accounts[0].EmailAddress)
+}
+
+func TestListTemplatesPager(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/template/list", r.URL.Path)
+		assert.Equal(t, "title:NDA", r.URL.Query().Get("query"))
+		assert.Equal(t, "2", r.URL.Query().Get("page_size"))
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(model.TemplateListResponse{
+			Templates: []model.TemplateResponse{
+				{TemplateId: fmt.Sprintf("template-%d-a", page)},
+				{TemplateId: fmt.Sprintf("template-%d-b", page)},
+			},
+			ListInfo: model.ListInfoResponse{NumPages: 2, NumResults: 4, Page: page, PageSize: 2},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	pager := client.ListTemplatesPager(context.Background(), model.ListTemplatesRequest{PageSize: 2, Query: "title:NDA"}, hellosign.PagerOptions{})
+	defer pager.Close()
+
+	var ids []string
+	for pager.Next() {
+		ids = append(ids, pager.Item().TemplateId)
+	}
+	require.NoError(t, pager.Err())
+	assert.Equal(t, []string{"template-1-a", "template-1-b", "template-2-a", "template-2-b"}, ids)
+}
+
+func TestTemplateManagement(t *testing.T) {
+	var paths []string
+	var body map[string]any
+	var form map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		paths = append(paths, r.URL.Path)
+		body, form = nil, nil
+		if r.Header.Get("Content-Type") == "application/json" {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		} else if r.ParseMultipartForm(1<<20) == nil {
+			form = r.MultipartForm.Value
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v3/template/delete/f57db65d3f933b5316d398057a36176831451a35":
+		case "/v3/template/create":
+			w.Write([]byte(`{"template": {"template_id": "61a832ff0d8423f91d503e76bfbcc750f7417c78"}}`))
+		case "/v3/template/create_embedded_draft":
+			w.Write([]byte(`{"template": {"template_id": "61a832ff0d8423f91d503e76bfbcc750f7417c78", "edit_url": "https://app.hellosign.com/editor/embeddedTemplate?token=abc", "expires_at": 1414561729}}`))
+		case "/v3/template/update_files/f57db65d3f933b5316d398057a36176831451a35":
+			w.Write([]byte(`{"template": {"template_id": "21f920ec2b7f4b6bb64d3ed79f26303843046536"}}`))
+		default:
+			http.ServeFile(w, r, "testdata/get_template.resp.json")
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	id := "f57db65d3f933b5316d398057a36176831451a35"
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+
+	_, err := client.AddUserToTemplate(ctx, id, model.TemplateAddUserRequest{EmailAddress: "george@example.org", SkipNotification: true})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"email_address": "george@example.org", "skip_notification": true}, body)
+
+	_, err = client.RemoveUserFromTemplate(ctx, id, model.TemplateRemoveUserRequest{AccountId: "5008b25c7f67153e57d5a357b1687968068fb465"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"account_id": "5008b25c7f67153e57d5a357b1687968068fb465"}, body)
+
+	order := 0
+	created, err := client.CreateTemplate(ctx, model.TemplateCreateRequest{
+		Files:       []*model.File{model.FileFromBytes("nda.pdf", []byte("%PDF-1.4"))},
+		SignerRoles: []model.SubTemplateRole{{Name: "Client", Order: &order}},
+		CCRoles:     []string{"Manager"},
+		MergeFields: []model.SubMergeField{{Name: "Effective Date", Type: model.MergeFieldTypeText}},
+		FormFieldsPerDocument: []model.SubFormFieldsPerDocument{
+			{ApiId: "sig", Type: model.FormFieldTypeSignature, Signer: "0", Width: 200, Height: 40, Required: true},
+		},
+		Title: "Mutual NDA",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "61a832ff0d8423f91d503e76bfbcc750f7417c78", created.Template.TemplateId)
+	assert.Equal(t, []string{"Client"}, form["signer_roles[0][name]"])
+	assert.Equal(t, []string{"0"}, form["signer_roles[0][order]"])
+	assert.Equal(t, []string{"Manager"}, form["cc_roles[0]"])
+	assert.Equal(t, []string{"Effective Date"}, form["merge_fields[0][name]"])
+	assert.Equal(t, []string{"signature"}, form["form_fields_per_document[0][type]"])
+
+	draft, err := client.CreateEmbeddedTemplateDraft(ctx, model.TemplateCreateEmbeddedDraftRequest{
+		ClientId:    "37dee8d8440c66d54cfa05d92c160882",
+		FileUrls:    []string{"https://example.org/nda.pdf"},
+		SignerRoles: []model.SubTemplateRole{{Name: "Client"}},
+		TestMode:    true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://app.hellosign.com/editor/embeddedTemplate?token=abc", draft.Template.EditURL)
+	assert.Equal(t, int64(1414561729), draft.Template.ExpiresAt.Unix())
+	assert.Equal(t, "37dee8d8440c66d54cfa05d92c160882", body["client_id"])
+
+	updated, err := client.UpdateTemplateFiles(ctx, id, model.TemplateUpdateFilesRequest{
+		Files: []*model.File{model.FileFromBytes("nda-v2.pdf", []byte("%PDF-1.4"))},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "21f920ec2b7f4b6bb64d3ed79f26303843046536", updated.Template.TemplateId)
+
+	require.NoError(t, client.DeleteTemplate(ctx, id))
+
+	assert.Equal(t, []string{
+		"/v3/template/add_user/" + id,
+		"/v3/template/remove_user/" + id,
+		"/v3/template/create",
+		"/v3/template/create_embedded_draft",
+		"/v3/template/update_files/" + id,
+		"/v3/template/delete/" + id,
+	}, paths)
+}
+
+func TestTemplateFiles(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		switch r.URL.Path {
+		case "/v3/template/files/some-id":
+			assert.Equal(t, "zip", r.URL.Query().Get("file_type"))
+			w.Header().Set("Content-Type", "application/zip")
+			w.Write([]byte("PK"))
+		case "/v3/template/files_as_data_uri/some-id":
+			w.Write([]byte(`{"data_uri": "data:application/pdf;base64,JVBERi0xLjQ="}`))
+		case "/v3/template/files_as_file_url/some-id":
+			assert.Equal(t, "1", r.URL.Query().Get("force_download"))
+			w.Write([]byte(`{"file_url": "https://s3.amazonaws.com/hellofax_uploads/template.pdf", "expires_at": 1636562340}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+
+	data, err := client.TemplateFiles(ctx, "some-id", "zip")
+	require.NoError(t, err)
+	assert.Equal(t, "PK", string(data))
+
+	download, err := client.TemplateFilesStream(ctx, "some-id", "zip")
+	require.NoError(t, err)
+	assert.Equal(t, "application/zip", download.ContentType)
+	require.NoError(t, download.Close())
+
+	dataUri, err := client.TemplateFilesAsDataUri(ctx, "some-id")
+	require.NoError(t, err)
+	contents, _, err := dataUri.Decode()
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF-1.4", string(contents))
+
+	fileUrl, err := client.TemplateFilesAsFileUrl(ctx, "some-id", true)
+	require.NoError(t, err)
+	assert.Equal(t, "https://s3.amazonaws.com/hellofax_uploads/template.pdf", fileUrl.FileUrl)
+}
@@ -2,6 +2,7 @@ package hellosign_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -42,8 +43,345 @@ func TestClient(t *testing.T) {
 	}
 }
 
+func TestGetSignatureRequest(t *testing.T) {
+	server := setupMockAPIServer()
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	srResp, err := client.GetSignatureRequest(ctx, "ebaae602348695a4c712aa0f22614986d03caaaa")
+	require.NoError(t, err)
+	assert.Equal(t, "ebaae602348695a4c712aa0f22614986d03caaaa", srResp.SignatureRequest.SignatureRequestId)
+
+	_, err = client.GetSignatureRequest(ctx, "missing")
+	assert.True(t, hellosign.IsNotFound(err))
+}
+
+func TestListSignatureRequests(t *testing.T) {
+	server := setupMockAPIServer()
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	listResp, err := client.ListSignatureRequests(ctx, model.ListSignatureRequestsRequest{
+		AccountId: "all",
+		PageSize:  20,
+		Query:     "title:Agreement",
+	})
+	require.NoError(t, err)
+	require.Len(t, listResp.SignatureRequests, 2)
+	assert.Equal(t, "fbaae602348695a4c712aa0f22614986d03cbbbb", listResp.SignatureRequests[1].SignatureRequestId)
+	assert.Equal(t, model.ListInfoResponse{NumPages: 1, NumResults: 2, Page: 1, PageSize: 20}, listResp.ListInfo)
+}
+
+func TestSendSignatureRequest(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/signature_request/send", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		http.ServeFile(w, r, "testdata/create_embedded_with_template.resp.json")
+	}))
+	t.Cleanup(server.Close)
+
+	order := 1
+	expiresAt := time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC)
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	srResp, err := client.SendSignatureRequest(context.Background(), model.SendSignatureRequestRequest{
+		FileUrls: []string{"https://example.org/agreement.pdf"},
+		Signers: []model.SubSignatureRequestSigner{
+			{Name: "Signer One", EmailAddress: "signer.one@example.org", Order: &order},
+		},
+		FormFieldsPerDocument: []model.SubFormFieldsPerDocument{
+			{DocumentIndex: 0, ApiId: "sig1", Type: model.FormFieldTypeSignature, Signer: "0", X: 100, Y: 600, Width: 240, Height: 40, Required: true},
+		},
+		Attachments:        []model.SubAttachment{{Name: "Photo ID", SignerIndex: 0, Required: true}},
+		FieldOptions:       &model.SubFieldOptions{DateFormat: "DD - MM - YYYY"},
+		SigningRedirectUrl: "https://example.org/signed",
+		UseTextTags:        true,
+		ExpiresAt:          &model.UnixTimestamp{Time: expiresAt},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ebaae602348695a4c712aa0f22614986d03caaaa", srResp.SignatureRequest.SignatureRequestId)
+
+	assert.Equal(t, []any{"https://example.org/agreement.pdf"}, body["file_urls"])
+	assert.Equal(t, float64(1), body["signers"].([]any)[0].(map[string]any)["order"])
+	assert.Equal(t, "sig1", body["form_fields_per_document"].([]any)[0].(map[string]any)["api_id"])
+	assert.Equal(t, float64(expiresAt.Unix()), body["expires_at"])
+	assert.Equal(t, true, body["use_text_tags"])
+	assert.NotContains(t, body, "files")
+	assert.NotContains(t, body, "hide_text_tags")
+}
+
+func TestSendWithTemplate(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/signature_request/send_with_template", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		http.ServeFile(w, r, "testdata/create_embedded_with_template.resp.json")
+	}))
+	t.Cleanup(server.Close)
+
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	_, err := client.SendWithTemplate(context.Background(), model.SendWithTemplateRequest{
+		TemplateIds:  []string{"cccc6ad681229567aab20cd83a69cf18fb2cccc"},
+		Signers:      []model.SubSignatureRequestTemplateSigner{{Role: "First", Name: "Signer One", EmailAddress: "signer.one@example.org"}},
+		CCs:          []model.SubCC{{Role: "Manager", EmailAddress: "manager@example.org"}},
+		CustomFields: []model.SubCustomField{{Name: "FullName1", Value: "Signer One"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Manager", body["ccs"].([]any)[0].(map[string]any)["role"])
+	assert.Equal(t, "Signer One", body["custom_fields"].([]any)[0].(map[string]any)["value"])
+}
+
+func TestCreateEmbedded(t *testing.T) {
+	var form map[string][]string
+	var fileNames []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/signature_request/create_embedded", r.URL.Path)
+		if !assert.NoError(t, r.ParseMultipartForm(1<<20)) {
+			http.Error(w, "bad form", http.StatusBadRequest)
+			return
+		}
+		form = r.MultipartForm.Value
+		for name := range r.MultipartForm.File {
+			fileNames = append(fileNames, name)
+		}
+		http.ServeFile(w, r, "testdata/create_embedded_with_template.resp.json")
+	}))
+	t.Cleanup(server.Close)
+
+	checked := false
+	page := 2
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	srResp, err := client.CreateEmbedded(context.Background(), model.CreateEmbeddedRequest{
+		ClientId: "ddddb5e5c34b929957e24b17aa52dddd",
+		Files:    []*model.File{model.FileFromBytes("agreement.pdf", []byte("%PDF-1.4"))},
+		GroupedSigners: []model.SubSignatureRequestGroupedSigners{
+			{Group: "Parents", Signers: []model.SubSignatureRequestSigner{
+				{Name: "Parent One", EmailAddress: "parent.one@example.org"},
+				{Name: "Parent Two", EmailAddress: "parent.two@example.org"},
+			}},
+		},
+		FormFieldsPerDocument: []model.SubFormFieldsPerDocument{
+			{ApiId: "name", Type: model.FormFieldTypeText, Signer: "0", Width: 200, Height: 20, ValidationType: "letters_only", Page: &page},
+			{ApiId: "agree", Type: model.FormFieldTypeCheckbox, Signer: "0", Width: 20, Height: 20, IsChecked: &checked},
+			{ApiId: "plan", Type: model.FormFieldTypeDropdown, Signer: "0", Width: 100, Height: 20, Options: []string{"Basic", "Pro"}, Content: "Pro"},
+			{ApiId: "terms", Type: model.FormFieldTypeHyperlink, Signer: "0", Width: 100, Height: 20, Content: "Terms", ContentUrl: "https://example.org/terms"},
+			{ApiId: "sig", Type: model.FormFieldTypeSignature, Signer: "0", Width: 200, Height: 40, Required: true},
+		},
+		FormFieldGroups: []model.SubFormFieldGroup{{GroupId: "options", GroupLabel: "Options", Requirement: "require_0-1"}},
+		FormFieldRules: []model.SubFormFieldRule{{
+			Id:              "hide-plan",
+			TriggerOperator: "AND",
+			Triggers:        []model.SubFormFieldRuleTrigger{{Id: "agree", Operator: "is", Value: "0"}},
+			Actions:         []model.SubFormFieldRuleAction{{Hidden: true, Type: "change-field-visibility", FieldId: "plan"}},
+		}},
+		TestMode: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ebaae602348695a4c712aa0f22614986d03caaaa", srResp.SignatureRequest.SignatureRequestId)
+
+	assert.Equal(t, []string{"files[0]"}, fileNames)
+	assert.Equal(t, []string{"Parents"}, form["grouped_signers[0][group]"])
+	assert.Equal(t, []string{"parent.two@example.org"}, form["grouped_signers[0][signers][1][email_address]"])
+	assert.Equal(t, []string{"letters_only"}, form["form_fields_per_document[0][validation_type]"])
+	assert.Equal(t, []string{"2"}, form["form_fields_per_document[0][page]"])
+	assert.Equal(t, []string{"false"}, form["form_fields_per_document[1][is_checked]"])
+	assert.Equal(t, []string{"Pro"}, form["form_fields_per_document[2][options][1]"])
+	assert.Equal(t, []string{"https://example.org/terms"}, form["form_fields_per_document[3][content_url]"])
+	assert.Equal(t, []string{"true"}, form["form_fields_per_document[4][required]"])
+	assert.NotContains(t, form, "form_fields_per_document[4][is_checked]")
+	assert.Equal(t, []string{"require_0-1"}, form["form_field_groups[0][requirement]"])
+	assert.Equal(t, []string{"agree"}, form["form_field_rules[0][triggers][0][id]"])
+	assert.Equal(t, []string{"plan"}, form["form_field_rules[0][actions][0][field_id]"])
+	assert.NotContains(t, form, "form_field_rules[0][actions][0][group_id]")
+}
+
+func TestSignatureRequestLifecycle(t *testing.T) {
+	var paths []string
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		paths = append(paths, r.URL.Path)
+		body = nil
+		if r.ContentLength > 0 {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		}
+		switch r.URL.Path {
+		case "/v3/signature_request/cancel/fa5c8a0b0f492d768749333ad6fcc214c111e967",
+			"/v3/signature_request/remove/fa5c8a0b0f492d768749333ad6fcc214c111e967":
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.ServeFile(w, r, "testdata/create_embedded_with_template.resp.json")
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	id := "fa5c8a0b0f492d768749333ad6fcc214c111e967"
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+
+	srResp, err := client.RemindSignatureRequest(ctx, id, model.RemindSignatureRequestRequest{EmailAddress: "signer.one@example.org"})
+	require.NoError(t, err)
+	assert.Equal(t, "ebaae602348695a4c712aa0f22614986d03caaaa", srResp.SignatureRequest.SignatureRequestId)
+	assert.Equal(t, map[string]any{"email_address": "signer.one@example.org"}, body)
+
+	expiresAt := time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC)
+	_, err = client.UpdateSignatureRequest(ctx, id, model.UpdateSignatureRequestRequest{
+		SignatureId: "2f9781e1a8e2045224d808c153c2e1d3df6f8f2f",
+		Name:        "Signer Uno",
+		ExpiresAt:   &model.UnixTimestamp{Time: expiresAt},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"signature_id": "2f9781e1a8e2045224d808c153c2e1d3df6f8f2f",
+		"name":         "Signer Uno",
+		"expires_at":   float64(expiresAt.Unix()),
+	}, body)
+
+	require.NoError(t, client.CancelSignatureRequest(ctx, id))
+	require.NoError(t, client.RemoveSignatureRequest(ctx, id))
+	_, err = client.ReleaseHold(ctx, id)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"/v3/signature_request/remind/" + id,
+		"/v3/signature_request/update/" + id,
+		"/v3/signature_request/cancel/" + id,
+		"/v3/signature_request/remove/" + id,
+		"/v3/signature_request/release_hold/" + id,
+	}, paths)
+}
+
+func TestSignatureRequestLifecycleErrors(t *testing.T) {
+	errorMsg := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(model.ErrorResponse{Error: model.ErrorResponseError{ErrorName: "bad_request", ErrorMsg: errorMsg}})
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+
+	errorMsg = "Cannot send reminders for embedded signature requests"
+	_, err := client.RemindSignatureRequest(ctx, "some-id", model.RemindSignatureRequestRequest{EmailAddress: "signer.one@example.org"})
+	assert.ErrorIs(t, err, hellosign.ErrEmbeddedSignatureRequest)
+	var apiErr *hellosign.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "bad_request", apiErr.ErrorName)
+
+	errorMsg = "This request has already been completed"
+	err = client.CancelSignatureRequest(ctx, "some-id")
+	assert.ErrorIs(t, err, hellosign.ErrSignatureRequestComplete)
+
+	errorMsg = "Signature request is not on hold"
+	_, err = client.ReleaseHold(ctx, "some-id")
+	assert.ErrorIs(t, err, hellosign.ErrSignatureRequestNotOnHold)
+	assert.NotErrorIs(t, err, hellosign.ErrSignatureRequestComplete)
+
+	for _, errorMsg = range []string{
+		"Invalid signature_id",
+		"Signature request is incomplete",
+		"Signature request is not complete",
+		"This request is not embedded",
+		"Invalid placeholder for account holder",
+	} {
+		err = client.CancelSignatureRequest(ctx, "some-id")
+		require.ErrorAs(t, err, &apiErr, errorMsg)
+		assert.NotErrorIs(t, err, hellosign.ErrEmbeddedSignatureRequest, errorMsg)
+		assert.NotErrorIs(t, err, hellosign.ErrSignatureRequestComplete, errorMsg)
+		assert.NotErrorIs(t, err, hellosign.ErrSignatureRequestNotOnHold, errorMsg)
+	}
+}
+
+func TestGetEmbeddedEditUrl(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/embedded/edit_url/f57db65d3f933b5316d398057a36176831451a35", r.URL.Path)
+		body = nil
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"embedded": {"edit_url": "https://embedded.hellosign.com/prep-and-send/embedded-template?cached_params_token=abc", "expires_at": 1414561729}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	hideStepper := false
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	resp, err := client.GetEmbeddedEditUrl(context.Background(), "f57db65d3f933b5316d398057a36176831451a35", model.EmbeddedEditUrlRequest{
+		CCRoles:             &[]string{"Manager"},
+		EditorOptions:       &model.SubEditorOptions{AllowEditSigners: true},
+		MergeFields:         &[]model.SubMergeField{{Name: "Full Name", Type: model.MergeFieldTypeText}},
+		ShowProgressStepper: &hideStepper,
+		TestMode:            true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://embedded.hellosign.com/prep-and-send/embedded-template?cached_params_token=abc", resp.Embedded.EditURL)
+	assert.Equal(t, int64(1414561729), resp.Embedded.ExpiresAt.Unix())
+
+	assert.Equal(t, map[string]any{
+		"cc_roles":              []any{"Manager"},
+		"editor_options":        map[string]any{"allow_edit_signers": true},
+		"merge_fields":          []any{map[string]any{"name": "Full Name", "type": "text"}},
+		"show_progress_stepper": false,
+		"test_mode":             true,
+	}, body)
+
+	// Empty slices remove all the CC roles and merge fields of the template
+	_, err = client.GetEmbeddedEditUrl(context.Background(), "f57db65d3f933b5316d398057a36176831451a35", model.EmbeddedEditUrlRequest{
+		CCRoles:     &[]string{},
+		MergeFields: &[]model.SubMergeField{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"cc_roles": []any{}, "merge_fields": []any{}}, body)
+}
+
+func TestRequestValidation(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.ServeFile(w, r, "testdata/create_embedded_with_template.resp.json")
+	}))
+	t.Cleanup(server.Close)
+
+	invalid := model.SendSignatureRequestRequest{
+		Files:   []*model.File{model.FileFromBytes("agreement.pdf", []byte("%PDF-1.4"))},
+		Signers: []model.SubSignatureRequestSigner{{Name: "Signer One", EmailAddress: "signer.one@example.org", Pin: "12"}},
+	}
+
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	_, err := client.SendSignatureRequest(context.Background(), invalid)
+	var validationErr *model.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "signers[0].pin", validationErr.Errors[0].Field)
+	_, err = client.ListSignatureRequests(context.Background(), model.ListSignatureRequestsRequest{PageSize: 500})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, 0, requests)
+
+	client = hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"), hellosign.WithoutValidation())
+	_, err = client.SendSignatureRequest(context.Background(), invalid)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+}
+
 func setupMockAPIServer() *httptest.Server {
 	mux := http.NewServeMux()
+	mux.HandleFunc("/v3/signature_request/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/signature_request/ebaae602348695a4c712aa0f22614986d03caaaa" {
+			http.Error(w, `{"error": {"error_msg": "Not found", "error_name": "not_found"}}`, http.StatusNotFound)
+			return
+		}
+		http.ServeFile(w, r, "testdata/create_embedded_with_template.resp.json")
+	})
+	mux.HandleFunc("/v3/signature_request/list", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("account_id") != "all" || query.Get("page_size") != "20" || query.Get("query") != "title:Agreement" {
+			http.Error(w, `{"error": {"error_msg": "Unexpected query", "error_name": "bad_request"}}`, http.StatusBadRequest)
+			return
+		}
+		http.ServeFile(w, r, "testdata/list_signature_requests.resp.json")
+	})
 	mux.HandleFunc("/v3/signature_request/create_embedded_with_template", func(w http.ResponseWriter, r *http.Request) {
 		http.ServeFile(w, r, "testdata/create_embedded_with_template.resp.json")
 	})
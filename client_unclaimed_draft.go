@@ -0,0 +1,70 @@
+package hellosign
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sean-rn/hellosign-sdk/model"
+)
+
+// CreateUnclaimedDraft Creates a new Draft that can be claimed using the claim URL. The first authenticated user to access the URL
+// will claim the Draft and will be shown either the "Sign and send" or the "Request signature" page with the Draft loaded.
+// Subsequent access to the claim URL will result in a 404.
+func (c *Client) CreateUnclaimedDraft(ctx context.Context, r model.UnclaimedDraftCreateRequest) (*model.UnclaimedDraftCreateResponse, error) {
+	furl := fmt.Sprintf("%s/v3/unclaimed_draft/create", c.baseURL)
+	req, err := c.newRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.UnclaimedDraftCreateResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// CreateEmbeddedUnclaimedDraft Creates a new Draft that can be claimed and used in an embedded iFrame. The first authenticated
+// user to access the URL will claim the Draft and will be shown the "Request signature" page with the Draft loaded. Subsequent
+// access to the claim URL will result in a `404`. For this embedded endpoint the `requester_email_address` parameter is required.
+func (c *Client) CreateEmbeddedUnclaimedDraft(ctx context.Context, r model.UnclaimedDraftCreateEmbeddedRequest) (*model.UnclaimedDraftCreateResponse, error) {
+	furl := fmt.Sprintf("%s/v3/unclaimed_draft/create_embedded", c.baseURL)
+	req, err := c.newRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.UnclaimedDraftCreateResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// CreateEmbeddedUnclaimedDraftWithTemplate Creates a new Draft with a previously saved template(s) that can be claimed and used
+// in an embedded iFrame. The first authenticated user to access the URL will claim the Draft and will be shown the "Request
+// signature" page with the Draft loaded. Subsequent access to the claim URL will result in a `404`. For this embedded endpoint the
+// `requester_email_address` parameter is required.
+func (c *Client) CreateEmbeddedUnclaimedDraftWithTemplate(ctx context.Context, r model.UnclaimedDraftCreateEmbeddedWithTemplateRequest) (*model.UnclaimedDraftCreateResponse, error) {
+	furl := fmt.Sprintf("%s/v3/unclaimed_draft/create_embedded_with_template", c.baseURL)
+	req, err := c.newRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.UnclaimedDraftCreateResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
+
+// EditAndResendUnclaimedDraft Creates a new signature request from an embedded request that can be edited prior to being sent to
+// the recipients. Parameter `test_mode` can be edited prior to request. Signers can be edited in embedded editor. Requester's email
+// address will remain unchanged if `requester_email_address` parameter is not set.  **NOTE:** Embedded unclaimed drafts can only be
+// accessed in embedded iFrames whereas normal drafts can be used and accessed on Dropbox Sign.
+// Parameters:
+//   - signatureRequestId The id of the SignatureRequest to edit and resend.
+func (c *Client) EditAndResendUnclaimedDraft(ctx context.Context, signatureRequestId string, r model.UnclaimedDraftEditAndResendRequest) (*model.UnclaimedDraftCreateResponse, error) {
+	furl := fmt.Sprintf("%s/v3/unclaimed_draft/edit_and_resend/%s", c.baseURL, url.PathEscape(signatureRequestId))
+	req, err := c.newJSONRequest(ctx, http.MethodPost, furl, r)
+	if err != nil {
+		return nil, err
+	}
+	var resp model.UnclaimedDraftCreateResponse
+	err = c.doRequest(req, &resp)
+	return &resp, err
+}
@@ -0,0 +1,113 @@
+package hellosign_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sean-rn/hellosign-sdk"
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const unclaimedDraftResponse = `{"unclaimed_draft": {"signature_request_id": "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+	"claim_url": "https://app.hellosign.com/send/resendDocs?root_snapshot_guids[]=7f967b7d06e154394eab693febedf61e8ebe49eb",
+	"signing_redirect_url": null, "requesting_redirect_url": "https://example.com/requested", "expires_at": 1532640962, "test_mode": true}}`
+
+func TestUnclaimedDrafts(t *testing.T) {
+	var path string
+	var body map[string]any
+	var form map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		path = r.URL.Path
+		body, form = nil, nil
+		if r.Header.Get("Content-Type") == "application/json" {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		} else {
+			if !assert.NoError(t, r.ParseMultipartForm(1<<20)) {
+				http.Error(w, "bad form", http.StatusBadRequest)
+				return
+			}
+			form = r.MultipartForm.Value
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(unclaimedDraftResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	ctx := context.Background()
+
+	resp, err := client.CreateUnclaimedDraft(ctx, model.UnclaimedDraftCreateRequest{
+		Type:     model.UnclaimedDraftTypeRequestSignature,
+		FileUrls: []string{"https://example.com/contract.pdf"},
+		Signers:  []model.SubUnclaimedDraftSigner{{Name: "Jack", EmailAddress: "jack@example.com"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/v3/unclaimed_draft/create", path)
+	assert.Equal(t, "request_signature", body["type"])
+	assert.Equal(t, "fa5c8a0b0f492d768749333ad6fcc214c111e967", resp.UnclaimedDraft.SignatureRequestId)
+	assert.Contains(t, resp.UnclaimedDraft.ClaimURL, "https://app.hellosign.com/send/resendDocs")
+	assert.Equal(t, "https://example.com/requested", resp.UnclaimedDraft.RequestingRedirectUrl)
+	require.NotNil(t, resp.UnclaimedDraft.ExpiresAt)
+	assert.Equal(t, int64(1532640962), resp.UnclaimedDraft.ExpiresAt.Unix())
+	assert.True(t, resp.UnclaimedDraft.TestMode)
+
+	_, err = client.CreateEmbeddedUnclaimedDraft(ctx, model.UnclaimedDraftCreateEmbeddedRequest{
+		ClientId:              "b6b8e7deaf8f0b95c029dca049356d4a2cf9710a",
+		RequesterEmailAddress: "jack@example.com",
+		Files:                 []*model.File{model.FileFromBytes("contract.pdf", []byte("%PDF-1.4"))},
+		IsForEmbeddedSigning:  true,
+		SkipMeNow:             true,
+		ShowPreview:           true,
+		HoldRequest:           true,
+		RequestingRedirectUrl: "https://example.com/requested",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/v3/unclaimed_draft/create_embedded", path)
+	assert.Equal(t, []string{"jack@example.com"}, form["requester_email_address"])
+	assert.Equal(t, []string{"true"}, form["is_for_embedded_signing"])
+	assert.Equal(t, []string{"true"}, form["skip_me_now"])
+	assert.Equal(t, []string{"true"}, form["show_preview"])
+	assert.Equal(t, []string{"true"}, form["hold_request"])
+	assert.Equal(t, []string{"https://example.com/requested"}, form["requesting_redirect_url"])
+
+	_, err = client.CreateEmbeddedUnclaimedDraftWithTemplate(ctx, model.UnclaimedDraftCreateEmbeddedWithTemplateRequest{
+		ClientId:              "b6b8e7deaf8f0b95c029dca049356d4a2cf9710a",
+		RequesterEmailAddress: "jack@example.com",
+		TemplateIds:           []string{"61a832ff0d8423f91d503e76bfbcc750f7417c78"},
+		Signers:               []model.SubUnclaimedDraftTemplateSigner{{Role: "Client", Name: "George", EmailAddress: "george@example.com"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/v3/unclaimed_draft/create_embedded_with_template", path)
+	assert.Equal(t, "Client", body["signers"].([]any)[0].(map[string]any)["role"])
+
+	_, err = client.EditAndResendUnclaimedDraft(ctx, "fa5c8a0b0f492d768749333ad6fcc214c111e967", model.UnclaimedDraftEditAndResendRequest{
+		ClientId: "b6b8e7deaf8f0b95c029dca049356d4a2cf9710a",
+		TestMode: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/v3/unclaimed_draft/edit_and_resend/fa5c8a0b0f492d768749333ad6fcc214c111e967", path)
+	assert.Equal(t, true, body["test_mode"])
+
+	path = ""
+	_, err = client.CreateEmbeddedUnclaimedDraft(ctx, model.UnclaimedDraftCreateEmbeddedRequest{
+		ClientId:  "b6b8e7deaf8f0b95c029dca049356d4a2cf9710a",
+		FileUrls:  []string{"https://example.com/contract.pdf"},
+		Type:      model.UnclaimedDraftTypeSendDocument,
+		SkipMeNow: true,
+	})
+	var validationErr *model.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, []model.FieldError{
+		{Field: "requester_email_address", Message: "is required"},
+		{Field: "skip_me_now", Message: "cannot be used with type send_document"},
+	}, validationErr.Errors)
+	assert.Empty(t, path, "invalid requests must not be sent")
+}
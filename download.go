@@ -0,0 +1,28 @@
+package hellosign
+
+import (
+	"io"
+	"mime"
+	"net/http"
+)
+
+// FileDownload is a file streamed from the API.  It must be closed after reading its contents.
+type FileDownload struct {
+	io.ReadCloser        // The contents of the file.
+	ContentType   string // MIME type of the file, e.g. "application/pdf" or "application/zip".
+	ContentLength int64  // Size of the file in bytes, or -1 if unknown.
+	Filename      string // Name of the file from the Content-Disposition header, if provided.
+}
+
+// newFileDownload wraps the body of a successful response
+func newFileDownload(resp *http.Response) *FileDownload {
+	download := &FileDownload{
+		ReadCloser:    resp.Body,
+		ContentType:   resp.Header.Get("Content-Type"),
+		ContentLength: resp.ContentLength,
+	}
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		download.Filename = params["filename"]
+	}
+	return download
+}
@@ -0,0 +1,116 @@
+package hellosign_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sean-rn/hellosign-sdk"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadFilesStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/signature_request/files/ebaae602348695a4c712aa0f22614986d03caaaa", r.URL.Path)
+		assert.Equal(t, "pdf", r.URL.Query().Get("file_type"))
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", `attachment; filename="Agreement - Medical.pdf"`)
+		w.Write([]byte("%PDF-1.4 contents"))
+	}))
+	t.Cleanup(server.Close)
+
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	download, err := client.DownloadFilesStream(context.Background(), "ebaae602348695a4c712aa0f22614986d03caaaa", "pdf")
+	require.NoError(t, err)
+	defer download.Close()
+
+	assert.Equal(t, "application/pdf", download.ContentType)
+	assert.Equal(t, "Agreement - Medical.pdf", download.Filename)
+	assert.Equal(t, int64(len("%PDF-1.4 contents")), download.ContentLength)
+	data, err := io.ReadAll(download)
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF-1.4 contents", string(data))
+}
+
+func TestDownloadFilesWaitPolicy(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			http.Error(w, `{"error": {"error_msg": "Files are still being processed. Please try again later.", "error_name": "conflict"}}`, http.StatusConflict)
+			return
+		}
+		w.Write([]byte("%PDF-1.4 contents"))
+	}))
+	t.Cleanup(server.Close)
+
+	var waits []int
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithFilesWaitPolicy(hellosign.FilesWaitPolicy{
+		InitialInterval: time.Millisecond,
+		OnWait: func(attempt int, next time.Duration) {
+			waits = append(waits, attempt)
+		},
+	}))
+	data, err := client.DownloadFiles(context.Background(), "ebaae602348695a4c712aa0f22614986d03caaaa", "pdf")
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF-1.4 contents", string(data))
+	assert.Equal(t, []int{1, 2}, waits)
+
+	t.Run("gives up after timeout", func(t *testing.T) {
+		attempts = -100
+		client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithFilesWaitPolicy(hellosign.FilesWaitPolicy{
+			InitialInterval: 5 * time.Millisecond,
+			Timeout:         20 * time.Millisecond,
+		}))
+		_, err := client.DownloadFilesStream(context.Background(), "ebaae602348695a4c712aa0f22614986d03caaaa", "pdf")
+		assert.True(t, hellosign.IsFilesProcessing(err))
+	})
+
+	t.Run("stops when context is canceled", func(t *testing.T) {
+		attempts = -100
+		client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithFilesWaitPolicy(hellosign.FilesWaitPolicy{
+			InitialInterval: time.Hour,
+		}))
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, err := client.DownloadFiles(ctx, "ebaae602348695a4c712aa0f22614986d03caaaa", "pdf")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.True(t, hellosign.IsFilesProcessing(err))
+	})
+}
+
+func TestFilesAsFileUrl(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3/signature_request/files_as_file_url/ebaae602348695a4c712aa0f22614986d03caaaa":
+			assert.Equal(t, "0", r.URL.Query().Get("force_download"))
+			w.Write([]byte(`{"file_url": "https://s3.amazonaws.com/hellofax_uploads/super_groups/2024/10/28/agreement.pdf", "expires_at": 1730140000}`))
+		case "/v3/signature_request/files_as_data_uri/ebaae602348695a4c712aa0f22614986d03caaaa":
+			w.Write([]byte(`{"data_uri": "data:application/pdf;base64,JVBERi0xLjQ="}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	fileResp, err := client.FilesAsFileUrl(ctx, "ebaae602348695a4c712aa0f22614986d03caaaa", false)
+	require.NoError(t, err)
+	assert.Equal(t, "https://s3.amazonaws.com/hellofax_uploads/super_groups/2024/10/28/agreement.pdf", fileResp.FileUrl)
+	if assert.NotNil(t, fileResp.ExpiresAt) {
+		assert.Equal(t, int64(1730140000), fileResp.ExpiresAt.Unix())
+	}
+
+	dataResp, err := client.FilesAsDataUri(ctx, "ebaae602348695a4c712aa0f22614986d03caaaa")
+	require.NoError(t, err)
+	data, mimeType, err := dataResp.Decode()
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", mimeType)
+	assert.Equal(t, "%PDF-1.4", string(data))
+}
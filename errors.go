@@ -0,0 +1,142 @@
+package hellosign
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/sean-rn/hellosign-sdk/model"
+)
+
+const (
+	// maxErrorBodySize limits how much of a non-2xx response body is read into an APIError
+	maxErrorBodySize = 4096
+	// requestIDHeader is the response header carrying the id the API assigned to the request
+	requestIDHeader = "X-Request-Id"
+)
+
+// Errors identifying why the API refused an operation on a signature request.  They are returned wrapped together
+// with the *APIError, so both [errors.Is] and [errors.As] can be used on the error.
+var (
+	// ErrEmbeddedSignatureRequest is returned for operations not available on embedded signature requests, such as
+	// sending reminders or changing the email address of a signer.
+	ErrEmbeddedSignatureRequest = errors.New("not supported for embedded signature requests")
+	// ErrSignatureRequestComplete is returned for operations not available on signature requests that are complete.
+	ErrSignatureRequestComplete = errors.New("signature request is already complete")
+	// ErrSignatureRequestNotOnHold is returned when releasing the hold of a signature request that is not on hold.
+	ErrSignatureRequestNotOnHold = errors.New("signature request is not on hold")
+)
+
+// APIError is returned by Client methods when the API responds with a non-2xx status code.
+// It carries the decoded [model.ErrorResponse] when the API provided one.  Use [errors.As]
+// to retrieve it, or one of the Is* predicates such as [IsNotFound] to branch on common failures.
+type APIError struct {
+	StatusCode int         // HTTP status code of the response.
+	Status     string      // HTTP status line of the response, e.g. "404 Not Found".
+	ErrorName  string      // Name of the error (`error_name`), if the API returned one.
+	ErrorMsg   string      // Message describing the error (`error_msg`), if the API returned one.
+	ErrorPath  string      // Path at which the error occurred (`error_path`), if the API returned one.
+	RequestID  string      // Id of the failed request (from the X-Request-Id header), if present.
+	Header     http.Header // Headers of the error response.
+	Body       []byte      // Raw (possibly truncated) body of the error response.
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	switch {
+	case e.ErrorName != "" && e.ErrorMsg != "":
+		return fmt.Sprintf("request returned %s: %s: %s", e.Status, e.ErrorName, e.ErrorMsg)
+	case e.ErrorMsg != "":
+		return fmt.Sprintf("request returned %s: %s", e.Status, e.ErrorMsg)
+	default:
+		return fmt.Sprintf("request returned %s: %s", e.Status, e.Body)
+	}
+}
+
+// newAPIError builds an *APIError from a non-2xx response, decoding the body as a [model.ErrorResponse] when possible.
+func newAPIError(resp *http.Response) error {
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err != nil {
+		return fmt.Errorf("error reading error response: %s: %w", resp.Status, err)
+	}
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RequestID:  resp.Header.Get(requestIDHeader),
+		Header:     resp.Header,
+		Body:       respBody,
+	}
+	var errResp model.ErrorResponse
+	if json.Unmarshal(respBody, &errResp) == nil {
+		apiErr.ErrorName = errResp.Error.ErrorName
+		apiErr.ErrorMsg = errResp.Error.ErrorMsg
+		apiErr.ErrorPath = errResp.Error.ErrorPath
+	}
+	return apiErr
+}
+
+// IsNotFound reports whether err is an *APIError for a resource that does not exist (status 404).
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an *APIError caused by missing or invalid credentials (status 401).
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsPaymentRequired reports whether err is an *APIError caused by the account's plan or quota (status 402).
+func IsPaymentRequired(err error) bool {
+	return hasStatus(err, http.StatusPaymentRequired)
+}
+
+// IsFilesProcessing reports whether err is an *APIError indicating the requested files are still being
+// prepared (status 409).  The request may be retried later.
+func IsFilesProcessing(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsRateLimited reports whether err is an *APIError caused by exceeding the API rate limit (status 429).
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// hasStatus reports whether err is an *APIError with the given HTTP status code.
+func hasStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// signatureRequestErrors maps the phrases of the messages of the API's errors to the corresponding signature request
+// errors, in the order they are checked.  The API reports them with generic error names such as `bad_request`, so the
+// messages are matched on whole known phrases rather than single words, which also appear in unrelated messages such
+// as "Signature request is incomplete" or "This request is not embedded".
+var signatureRequestErrors = []struct {
+	pattern *regexp.Regexp
+	err     error
+}{
+	{regexp.MustCompile(`\b(for|on|of) embedded (signature )?requests?\b`), ErrEmbeddedSignatureRequest},
+	{regexp.MustCompile(`\bnot (on|in) hold\b`), ErrSignatureRequestNotOnHold},
+	{regexp.MustCompile(`\b(already (been )?completed?|has been completed|is (already )?complete)\b`), ErrSignatureRequestComplete},
+}
+
+// signatureRequestError wraps err with the signature request error it corresponds to, if it is an *APIError
+// rejecting the request (status 400 or 403) with a recognized message.
+func signatureRequestError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || (apiErr.StatusCode != http.StatusBadRequest && apiErr.StatusCode != http.StatusForbidden) {
+		return err
+	}
+	msg := strings.ToLower(apiErr.ErrorMsg)
+	for _, e := range signatureRequestErrors {
+		if e.pattern.MatchString(msg) {
+			return fmt.Errorf("%w: %w", e.err, err)
+		}
+	}
+	return err
+}
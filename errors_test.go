@@ -0,0 +1,58 @@
+package hellosign_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sean-rn/hellosign-sdk"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1234")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"error_msg": "Not found", "error_name": "not_found", "error_path": "signature_id"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	_, err := client.GetEmbeddedSignUrl(context.Background(), "missing")
+	require.Error(t, err)
+
+	var apiErr *hellosign.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "not_found", apiErr.ErrorName)
+	assert.Equal(t, "Not found", apiErr.ErrorMsg)
+	assert.Equal(t, "signature_id", apiErr.ErrorPath)
+	assert.Equal(t, "req-1234", apiErr.RequestID)
+	assert.Equal(t, "request returned 404 Not Found: not_found: Not found", apiErr.Error())
+
+	assert.True(t, hellosign.IsNotFound(err))
+	assert.False(t, hellosign.IsUnauthorized(err))
+	assert.False(t, hellosign.IsFilesProcessing(err))
+}
+
+func TestAPIErrorUndecodableBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "upstream unavailable", http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL))
+	_, err := client.DownloadFiles(context.Background(), "some-id", "pdf")
+	require.Error(t, err)
+
+	var apiErr *hellosign.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Empty(t, apiErr.ErrorName)
+	assert.Equal(t, "upstream unavailable\n", string(apiErr.Body))
+	assert.True(t, hellosign.IsRateLimited(err))
+}
@@ -0,0 +1,97 @@
+package hellosign
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/sean-rn/hellosign-sdk/model"
+)
+
+// ErrInvalidEventHash is matched (using [errors.Is]) by every *EventHashError.
+var ErrInvalidEventHash = errors.New("invalid event hash")
+
+// EventHashError is returned by [EventVerifier.Verify] when an event callback cannot be proven to come from Dropbox Sign.
+type EventHashError struct {
+	EventType model.EventType // Type of the event that failed verification.
+	AppId     string          // App the event was reported for, if it was an API app callback.
+	Reason    string          // Why verification failed.
+}
+
+// Error implements the error interface
+func (e *EventHashError) Error() string {
+	if e.AppId != "" {
+		return fmt.Sprintf("%s: %s event for app %s: %s", ErrInvalidEventHash, e.EventType, e.AppId, e.Reason)
+	}
+	return fmt.Sprintf("%s: %s event: %s", ErrInvalidEventHash, e.EventType, e.Reason)
+}
+
+// Unwrap allows errors.Is(err, ErrInvalidEventHash)
+func (e *EventHashError) Unwrap() error {
+	return ErrInvalidEventHash
+}
+
+// EventVerifier checks the `event_hash` of event callbacks, which Dropbox Sign generates as the hex encoded
+// HMAC-SHA256 of `event_time` + `event_type` keyed with the API key of the account (or, for API app callbacks,
+// of the app owner's account).
+type EventVerifier struct {
+	// The API key used to verify account callbacks, and app callbacks with no matching entry in AppApiKeys.
+	ApiKey string
+	// API keys used to verify API app callbacks, keyed by the app's client id (`reported_for_app_id`).
+	// Only needed when apps are owned by accounts other than the one ApiKey belongs to.
+	AppApiKeys map[string]string
+}
+
+// Verify recomputes the hash of the event in req and compares it in constant time with the `event_hash` it carries.
+// Returns nil if they match, otherwise an *EventHashError.
+func (v *EventVerifier) Verify(req *model.EventCallbackRequest) error {
+	event := req.Event
+	var appId string
+	if event.EventMetadata != nil {
+		appId = event.EventMetadata.ReportedForAppId
+	}
+	hashErr := func(reason string) error {
+		return &EventHashError{EventType: event.EventType, AppId: appId, Reason: reason}
+	}
+
+	key := v.ApiKey
+	if appKey, ok := v.AppApiKeys[appId]; ok && appId != "" {
+		key = appKey
+	}
+	if key == "" {
+		return hashErr("no API key configured")
+	}
+	if event.EventHash == "" {
+		return hashErr("missing event_hash")
+	}
+
+	got, err := hex.DecodeString(event.EventHash)
+	if err != nil {
+		return hashErr("malformed event_hash")
+	}
+	if !hmac.Equal(got, eventHashSum(key, event)) {
+		return hashErr("event_hash does not match")
+	}
+	return nil
+}
+
+// ComputeEventHash returns the hex encoded `event_hash` Dropbox Sign would send for event when signing it with apiKey.
+func ComputeEventHash(apiKey string, event model.EventCallbackRequestEvent) string {
+	return hex.EncodeToString(eventHashSum(apiKey, event))
+}
+
+// eventHashSum computes the HMAC-SHA256 of `event_time` + `event_type`.  The `event_time` is hashed as it was sent,
+// falling back to the decimal Unix time for events which were not decoded from JSON.
+func eventHashSum(apiKey string, event model.EventCallbackRequestEvent) []byte {
+	eventTime := event.RawEventTime
+	if eventTime == "" {
+		eventTime = strconv.FormatInt(event.EventTime.Unix(), 10)
+	}
+	mac := hmac.New(sha256.New, []byte(apiKey))
+	mac.Write([]byte(eventTime))
+	mac.Write([]byte(string(event.EventType)))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,68 @@
+package hellosign_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/sean-rn/hellosign-sdk"
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventVerifier(t *testing.T) {
+	mac := hmac.New(sha256.New, []byte("test-api-key"))
+	mac.Write([]byte("1730139997signature_request_all_signed"))
+	validHash := hex.EncodeToString(mac.Sum(nil))
+
+	newEvent := func(hash, appId string) *model.EventCallbackRequest {
+		var req model.EventCallbackRequest
+		err := json.Unmarshal([]byte(`{"event": {
+			"event_time": "1730139997",
+			"event_type": "signature_request_all_signed",
+			"event_hash": "`+hash+`",
+			"event_metadata": {"reported_for_app_id": "`+appId+`"}
+		}}`), &req)
+		require.NoError(t, err)
+		return &req
+	}
+
+	verifier := &hellosign.EventVerifier{ApiKey: "test-api-key"}
+	assert.NoError(t, verifier.Verify(newEvent(validHash, "")))
+	assert.NoError(t, verifier.Verify(newEvent(validHash, "some-app")))
+	assert.Equal(t, validHash, hellosign.ComputeEventHash("test-api-key", newEvent("", "").Event))
+
+	err := verifier.Verify(newEvent("eeeee284ef1e2e3796466ad4c5489bc308ff724e17bfc672bda147eaf0cb3ebe", ""))
+	assert.ErrorIs(t, err, hellosign.ErrInvalidEventHash)
+	var hashErr *hellosign.EventHashError
+	require.True(t, errors.As(err, &hashErr))
+	assert.Equal(t, model.EventTypeSignatureRequestAllSigned, hashErr.EventType)
+
+	assert.ErrorIs(t, verifier.Verify(newEvent("not-hex", "")), hellosign.ErrInvalidEventHash)
+	assert.ErrorIs(t, verifier.Verify(newEvent("", "")), hellosign.ErrInvalidEventHash)
+
+	// The hash covers event_time as sent, even when it is not in canonical form
+	for _, eventTime := range []string{`"01730139997"`, `1730139997`} {
+		var raw string
+		if err := json.Unmarshal([]byte(eventTime), &raw); err != nil {
+			raw = eventTime
+		}
+		mac := hmac.New(sha256.New, []byte("test-api-key"))
+		mac.Write([]byte(raw + "signature_request_all_signed"))
+		var req model.EventCallbackRequest
+		err := json.Unmarshal([]byte(`{"event": {"event_time": `+eventTime+`, "event_type": "signature_request_all_signed",
+			"event_hash": "`+hex.EncodeToString(mac.Sum(nil))+`"}}`), &req)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1730139997), req.Event.EventTime.Unix())
+		assert.NoError(t, verifier.Verify(&req), eventTime)
+	}
+
+	appVerifier := &hellosign.EventVerifier{ApiKey: "other-key", AppApiKeys: map[string]string{"some-app": "test-api-key"}}
+	assert.NoError(t, appVerifier.Verify(newEvent(validHash, "some-app")))
+	assert.ErrorIs(t, appVerifier.Verify(newEvent(validHash, "")), hellosign.ErrInvalidEventHash)
+}
@@ -0,0 +1,103 @@
+package hellosign
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sean-rn/hellosign-sdk/model"
+)
+
+// EventHandlerFunc processes an event callback routed by an EventRouter.
+// Returning an error prevents the callback from being acknowledged, so Dropbox Sign will retry it later.
+type EventHandlerFunc func(ctx context.Context, req *model.EventCallbackRequest) error
+
+// SignatureRequestEventFunc processes an event callback about a signature request.
+type SignatureRequestEventFunc func(ctx context.Context, event model.EventCallbackRequestEvent, sr *model.SignatureRequestResponse) error
+
+// SignerEventFunc processes an event callback about a single signer of a signature request. signature is the
+// entry of sr.Signatures identified by the `related_signature_id` of the event.
+type SignerEventFunc func(ctx context.Context, event model.EventCallbackRequestEvent, sr *model.SignatureRequestResponse, signature *model.SignatureRequestResponseSignatures) error
+
+// AccountEventFunc processes an event callback about an account, such as `account_confirmed`.
+type AccountEventFunc func(ctx context.Context, event model.EventCallbackRequestEvent, account *model.AccountResponse) error
+
+// TemplateEventFunc processes an event callback about a template, such as `template_created`.
+type TemplateEventFunc func(ctx context.Context, event model.EventCallbackRequestEvent, template *model.TemplateResponse) error
+
+// EventRouter routes event callbacks to the handler funcs registered for their event type.
+// The zero value is ready to use.  Handlers must be registered before Dispatch is called.
+type EventRouter struct {
+	handlers       map[model.EventType]EventHandlerFunc // handlers by event type
+	defaultHandler EventHandlerFunc                     // handler for event types without a registered handler
+}
+
+// HandleFunc registers fn to process callbacks of the given event type, replacing any previously registered for it.
+func (r *EventRouter) HandleFunc(eventType model.EventType, fn EventHandlerFunc) {
+	if r.handlers == nil {
+		r.handlers = make(map[model.EventType]EventHandlerFunc)
+	}
+	r.handlers[eventType] = fn
+}
+
+// HandleDefault registers fn to process callbacks whose event type has no registered handler.
+// Without a default handler such callbacks are ignored.
+func (r *EventRouter) HandleDefault(fn EventHandlerFunc) {
+	r.defaultHandler = fn
+}
+
+// HandleSignatureRequest registers fn to process callbacks of the given event type, which must carry a signature request.
+func (r *EventRouter) HandleSignatureRequest(eventType model.EventType, fn SignatureRequestEventFunc) {
+	r.HandleFunc(eventType, func(ctx context.Context, req *model.EventCallbackRequest) error {
+		if req.SignatureRequest == nil {
+			return fmt.Errorf("%w: %s event without signature_request", ErrMalformedEventCallback, eventType)
+		}
+		return fn(ctx, req.Event, req.SignatureRequest)
+	})
+}
+
+// HandleSigner registers fn to process callbacks of the given signer scoped event type (see [model.EventType.IsSignerScoped]).
+func (r *EventRouter) HandleSigner(eventType model.EventType, fn SignerEventFunc) {
+	r.HandleSignatureRequest(eventType, func(ctx context.Context, event model.EventCallbackRequestEvent, sr *model.SignatureRequestResponse) error {
+		if event.EventMetadata == nil || event.EventMetadata.RelatedSignatureId == "" {
+			return fmt.Errorf("%w: %s event without related_signature_id", ErrMalformedEventCallback, eventType)
+		}
+		for i := range sr.Signatures {
+			if sr.Signatures[i].SignatureId == event.EventMetadata.RelatedSignatureId {
+				return fn(ctx, event, sr, &sr.Signatures[i])
+			}
+		}
+		return fmt.Errorf("%w: %s event for unknown signature %s", ErrMalformedEventCallback, eventType, event.EventMetadata.RelatedSignatureId)
+	})
+}
+
+// HandleAccount registers fn to process callbacks of the given event type, which must carry an account.
+func (r *EventRouter) HandleAccount(eventType model.EventType, fn AccountEventFunc) {
+	r.HandleFunc(eventType, func(ctx context.Context, req *model.EventCallbackRequest) error {
+		if req.Account == nil {
+			return fmt.Errorf("%w: %s event without account", ErrMalformedEventCallback, eventType)
+		}
+		return fn(ctx, req.Event, req.Account)
+	})
+}
+
+// HandleTemplate registers fn to process callbacks of the given event type, which must carry a template.
+func (r *EventRouter) HandleTemplate(eventType model.EventType, fn TemplateEventFunc) {
+	r.HandleFunc(eventType, func(ctx context.Context, req *model.EventCallbackRequest) error {
+		if req.Template == nil {
+			return fmt.Errorf("%w: %s event without template", ErrMalformedEventCallback, eventType)
+		}
+		return fn(ctx, req.Event, req.Template)
+	})
+}
+
+// Dispatch calls the handler registered for the event type of req, or the default handler if there is none.
+func (r *EventRouter) Dispatch(ctx context.Context, req *model.EventCallbackRequest) error {
+	handler, ok := r.handlers[req.Event.EventType]
+	if !ok {
+		handler = r.defaultHandler
+	}
+	if handler == nil {
+		return nil
+	}
+	return handler(ctx, req)
+}
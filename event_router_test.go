@@ -0,0 +1,74 @@
+package hellosign_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sean-rn/hellosign-sdk"
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventRouter(t *testing.T) {
+	var router hellosign.EventRouter
+	var got []string
+	router.HandleSignatureRequest(model.EventTypeSignatureRequestAllSigned, func(ctx context.Context, event model.EventCallbackRequestEvent, sr *model.SignatureRequestResponse) error {
+		got = append(got, "all_signed:"+sr.SignatureRequestId)
+		return nil
+	})
+	router.HandleSigner(model.EventTypeSignatureRequestSigned, func(ctx context.Context, event model.EventCallbackRequestEvent, sr *model.SignatureRequestResponse, signature *model.SignatureRequestResponseSignatures) error {
+		got = append(got, "signed:"+signature.SignerName)
+		return nil
+	})
+	router.HandleTemplate(model.EventTypeTemplateCreated, func(ctx context.Context, event model.EventCallbackRequestEvent, template *model.TemplateResponse) error {
+		got = append(got, "template_created:"+template.TemplateId)
+		return nil
+	})
+	router.HandleDefault(func(ctx context.Context, req *model.EventCallbackRequest) error {
+		got = append(got, "default:"+string(req.Event.EventType))
+		return nil
+	})
+
+	sr := &model.SignatureRequestResponse{
+		SignatureRequestId: "sr-1",
+		Signatures: []model.SignatureRequestResponseSignatures{
+			{SignatureId: "sig-1", SignerName: "Signer One"},
+			{SignatureId: "sig-2", SignerName: "Signer Two"},
+		},
+	}
+	newEvent := func(eventType model.EventType, signatureId string) *model.EventCallbackRequest {
+		return &model.EventCallbackRequest{
+			Event: model.EventCallbackRequestEvent{
+				EventType:     eventType,
+				EventMetadata: &model.EventCallbackRequestEventMetadata{RelatedSignatureId: signatureId},
+			},
+			SignatureRequest: sr,
+		}
+	}
+
+	ctx := context.Background()
+	require.NoError(t, router.Dispatch(ctx, newEvent(model.EventTypeSignatureRequestAllSigned, "")))
+	require.NoError(t, router.Dispatch(ctx, newEvent(model.EventTypeSignatureRequestSigned, "sig-2")))
+	require.NoError(t, router.Dispatch(ctx, newEvent(model.EventTypeCallbackTest, "")))
+	require.NoError(t, router.Dispatch(ctx, &model.EventCallbackRequest{
+		Event:    model.EventCallbackRequestEvent{EventType: model.EventTypeTemplateCreated},
+		Template: &model.TemplateResponse{TemplateId: "tmpl-1"},
+	}))
+	assert.Equal(t, []string{"all_signed:sr-1", "signed:Signer Two", "default:callback_test", "template_created:tmpl-1"}, got)
+
+	err := router.Dispatch(ctx, newEvent(model.EventTypeSignatureRequestSigned, "sig-unknown"))
+	assert.ErrorIs(t, err, hellosign.ErrMalformedEventCallback)
+	err = router.Dispatch(ctx, &model.EventCallbackRequest{Event: model.EventCallbackRequestEvent{EventType: model.EventTypeSignatureRequestAllSigned}})
+	assert.ErrorIs(t, err, hellosign.ErrMalformedEventCallback)
+}
+
+func TestEventTypeClassification(t *testing.T) {
+	assert.True(t, model.EventTypeSignatureRequestAllSigned.IsTerminal())
+	assert.False(t, model.EventTypeSignatureRequestSigned.IsTerminal())
+	assert.True(t, model.EventTypeFileError.IsError())
+	assert.False(t, model.EventTypeTemplateCreated.IsError())
+	assert.True(t, model.EventTypeSignatureRequestViewed.IsSignerScoped())
+	assert.False(t, model.EventTypeSignatureRequestAllSigned.IsSignerScoped())
+}
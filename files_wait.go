@@ -0,0 +1,83 @@
+package hellosign
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultFilesWaitInitialInterval = time.Second
+	defaultFilesWaitMaxInterval     = 30 * time.Second
+)
+
+// FilesWaitPolicy configures how file downloads wait for files that are still being prepared, instead of failing
+// with the 409 Conflict the API returns in that case (see [IsFilesProcessing]).
+type FilesWaitPolicy struct {
+	// Delay before the first retry.  Defaults to 1 second.  Each following delay is twice the previous one.
+	InitialInterval time.Duration
+	// Upper bound of the delay between retries.  Defaults to 30 seconds.
+	MaxInterval time.Duration
+	// Stop waiting after this long, returning the last error.  Zero means wait as long as the context allows.
+	Timeout time.Duration
+	// OnWait, if set, is called before each wait with the number of attempts made so far and the delay until the next.
+	OnWait func(attempt int, next time.Duration)
+}
+
+// WithFilesWaitPolicy configures the methods obtaining the files of a signature request to poll with exponential
+// backoff while the files are being prepared, until they are ready or the context or policy's Timeout expires.
+func WithFilesWaitPolicy(policy FilesWaitPolicy) Option {
+	return func(c *Client) {
+		if policy.InitialInterval <= 0 {
+			policy.InitialInterval = defaultFilesWaitInitialInterval
+		}
+		if policy.MaxInterval <= 0 {
+			policy.MaxInterval = defaultFilesWaitMaxInterval
+		}
+		c.filesWait = &policy
+	}
+}
+
+// waitForFiles calls fn until it returns something other than a files processing error, waiting between calls as
+// configured by the client's FilesWaitPolicy.  Without a policy fn is called exactly once.
+func (c *Client) waitForFiles(ctx context.Context, fn func() error) error {
+	policy := c.filesWait
+	if policy == nil {
+		return fn()
+	}
+
+	var deadline time.Time
+	if policy.Timeout > 0 {
+		deadline = time.Now().Add(policy.Timeout)
+	}
+	delay := policy.InitialInterval
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if !IsFilesProcessing(err) {
+			return err
+		}
+		if !deadline.IsZero() && time.Now().Add(delay).After(deadline) {
+			return fmt.Errorf("files not ready after %d attempts: %w", attempt, err)
+		}
+
+		if policy.OnWait != nil {
+			policy.OnWait(attempt, delay)
+		}
+		if waitErr := sleepContext(ctx, delay); waitErr != nil {
+			return fmt.Errorf("files not ready after %d attempts: %w: %w", attempt, waitErr, err)
+		}
+		delay = min(2*delay, policy.MaxInterval)
+	}
+}
+
+// sleepContext pauses for d or until ctx is done, returning ctx.Err() in the latter case.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,56 @@
+package model
+
+// AccountGetResponse models the response from account API endpoints
+type AccountGetResponse struct {
+	Account  AccountResponse   `json:"account"`
+	Warnings []WarningResponse `json:"warnings,omitempty"` // A list of warnings.
+}
+
+// AccountResponse Contains information about an account and its settings.
+type AccountResponse struct {
+	// The ID of the Account
+	AccountId string `json:"account_id,omitempty"`
+	// The email address associated with the Account.
+	EmailAddress string `json:"email_address,omitempty"`
+	// Returns `true` if the user has been locked out of their account by a team admin.
+	IsLocked bool `json:"is_locked,omitempty"`
+	// Returns `true` if the user has a paid Dropbox Sign account.
+	IsPaidHs bool `json:"is_paid_hs,omitempty"`
+	// Returns `true` if the user has a paid HelloFax account.
+	IsPaidHf bool `json:"is_paid_hf,omitempty"`
+	// Details concerning remaining monthly quotas.
+	Quotas *AccountResponseQuotas `json:"quotas,omitempty"`
+	// The URL that Dropbox Sign events will `POST` to.
+	CallbackUrl string `json:"callback_url,omitempty"`
+	// The membership role for the team.
+	RoleCode string `json:"role_code,omitempty"`
+	// The id of the team account belongs to.
+	TeamId string `json:"team_id,omitempty"`
+	// The locale used in this Account. Check out the list of [supported locales](/api/reference/constants/#supported-locales) to learn
+	// more about the possible values.
+	Locale string `json:"locale,omitempty"`
+	// Details concerning monthly usage
+	Usage *AccountResponseUsage `json:"usage,omitempty"`
+}
+
+// AccountResponseQuotas Details concerning remaining monthly quotas.  A nil value means the quota is unlimited.
+type AccountResponseQuotas struct {
+	// API signature requests remaining.
+	ApiSignatureRequestsLeft *int `json:"api_signature_requests_left,omitempty"`
+	// Signature requests remaining.
+	DocumentsLeft *int `json:"documents_left,omitempty"`
+	// Total API templates allowed.
+	TemplatesTotal *int `json:"templates_total,omitempty"`
+	// API templates remaining.
+	TemplatesLeft *int `json:"templates_left,omitempty"`
+	// SMS verifications remaining.
+	SmsVerificationsLeft *int `json:"sms_verifications_left,omitempty"`
+	// Number of fax pages left
+	NumFaxPagesLeft *int `json:"num_fax_pages_left,omitempty"`
+}
+
+// AccountResponseUsage Details concerning monthly usage
+type AccountResponseUsage struct {
+	// Number of fax pages sent
+	FaxPages *int `json:"fax_pages,omitempty"`
+}
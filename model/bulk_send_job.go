@@ -0,0 +1,153 @@
+package model
+
+// SignatureRequestBulkSendWithTemplateRequest struct for SignatureRequestBulkSendWithTemplateRequest
+type SignatureRequestBulkSendWithTemplateRequest struct {
+	// Use `template_ids` to create a SignatureRequest from one or more templates, in the order in which the template will be used.
+	TemplateIds []string `json:"template_ids"`
+	// `signer_file` is a CSV file defining values and options for signer fields. Required unless a `signer_list` is used, you may
+	// not use both. The CSV can have the following columns:  - `name`: the name of the signer filling the role of RoleName -
+	// `email_address`: email address of the signer filling the role of RoleName - `pin`: the 4- to 12-character access code that will
+	// secure this signer's signature page (optional) - `sms_phone_number`: An E.164 formatted phone number that will receive a code
+	// via SMS to access this signer's signature page. (optional) - `*_field`: any column with a _field\" suffix will be treated as a
+	// custom field (optional)
+	SignerFile *File `json:"signer_file,omitempty"`
+	// `signer_list` is an array defining values and options for signer fields. Required unless a `signer_file` is used, you may not
+	// use both.
+	SignerList []SubBulkSignerList `json:"signer_list,omitempty"`
+	// Allows signers to decline to sign a document if `true`. Defaults to `false`.
+	AllowDecline bool `json:"allow_decline,omitempty"`
+	// Add CC email recipients. Required when a CC role exists for the Template.
+	CCs []SubCC `json:"ccs,omitempty"`
+	// The client id of the API App you want to associate with this request. Used to apply the branding and callback url defined for
+	// the app.
+	ClientId string `json:"client_id,omitempty"`
+	// When used together with merge fields, `custom_fields` allows users to add pre-filled data to their signature requests.
+	CustomFields []SubCustomField `json:"custom_fields,omitempty"`
+	// The custom message in the email that will be sent to the signers.
+	Message string `json:"message,omitempty"`
+	// Key-value data that should be attached to the signature request. This metadata is included in all API responses and events involving
+	// the signature request. For example, use the metadata field to store a signer's order number for look up when receiving events for the
+	// signature request.  Each request can include up to 10 metadata keys (or 50 nested metadata keys), with key names up to 40 characters
+	// long and values up to 1000 characters long.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// The URL you want signers redirected to after they successfully sign.
+	SigningRedirectUrl string `json:"signing_redirect_url,omitempty"`
+	// The subject in the email that will be sent to the signers.
+	Subject string `json:"subject,omitempty"`
+	// Whether this is a test, the signature request will not be legally binding if set to `true`. Defaults to `false`.
+	TestMode bool `json:"test_mode,omitempty"`
+	// The title you want to assign to the SignatureRequest.
+	Title string `json:"title,omitempty"`
+}
+
+// SignatureRequestBulkCreateEmbeddedWithTemplateRequest struct for SignatureRequestBulkCreateEmbeddedWithTemplateRequest
+type SignatureRequestBulkCreateEmbeddedWithTemplateRequest struct {
+	// Use `template_ids` to create a SignatureRequest from one or more templates, in the order in which the template will be used.
+	TemplateIds []string `json:"template_ids"`
+	// Client id of the app you're using to create this embedded signature request. Used for security purposes.
+	ClientId string `json:"client_id"`
+	// `signer_file` is a CSV file defining values and options for signer fields. Required unless a `signer_list` is used, you may
+	// not use both. The CSV can have the following columns:  - `name`: the name of the signer filling the role of RoleName -
+	// `email_address`: email address of the signer filling the role of RoleName - `pin`: the 4- to 12-character access code that will
+	// secure this signer's signature page (optional) - `sms_phone_number`: An E.164 formatted phone number that will receive a code
+	// via SMS to access this signer's signature page. (optional) - `*_field`: any column with a _field\" suffix will be treated as a
+	// custom field (optional)
+	SignerFile *File `json:"signer_file,omitempty"`
+	// `signer_list` is an array defining values and options for signer fields. Required unless a `signer_file` is used, you may not
+	// use both.
+	SignerList []SubBulkSignerList `json:"signer_list,omitempty"`
+	// Allows signers to decline to sign a document if `true`. Defaults to `false`.
+	AllowDecline bool `json:"allow_decline,omitempty"`
+	// Add CC email recipients. Required when a CC role exists for the Template.
+	CCs []SubCC `json:"ccs,omitempty"`
+	// When used together with merge fields, `custom_fields` allows users to add pre-filled data to their signature requests.
+	CustomFields []SubCustomField `json:"custom_fields,omitempty"`
+	// The custom message in the email that will be sent to the signers.
+	Message string `json:"message,omitempty"`
+	// Key-value data that should be attached to the signature request. This metadata is included in all API responses and events involving
+	// the signature request. For example, use the metadata field to store a signer's order number for look up when receiving events for the
+	// signature request.  Each request can include up to 10 metadata keys (or 50 nested metadata keys), with key names up to 40 characters
+	// long and values up to 1000 characters long.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// The URL you want signers redirected to after they successfully sign.
+	SigningRedirectUrl string `json:"signing_redirect_url,omitempty"`
+	// The subject in the email that will be sent to the signers.
+	Subject string `json:"subject,omitempty"`
+	// Whether this is a test, the signature request will not be legally binding if set to `true`. Defaults to `false`.
+	TestMode bool `json:"test_mode,omitempty"`
+	// The title you want to assign to the SignatureRequest.
+	Title string `json:"title,omitempty"`
+}
+
+// SubBulkSignerList struct for SubBulkSignerList
+type SubBulkSignerList struct {
+	// An array of custom field values.
+	CustomFields []SubBulkSignerListCustomField `json:"custom_fields,omitempty"`
+	// Add Signers to your Templated-based Signature Request. Allows the requester to specify editor options when a preparing a
+	// document.  Currently only templates with a single role are supported. All signers must have the same `role` value.
+	Signers []SubSignatureRequestTemplateSigner `json:"signers,omitempty"`
+}
+
+// SubBulkSignerListCustomField struct for SubBulkSignerListCustomField
+type SubBulkSignerListCustomField struct {
+	// The name of the custom field. Must be the field's `name` or `api_id`.
+	Name string `json:"name"`
+	// The value of the custom field.
+	Value string `json:"value"`
+}
+
+// BulkSendJobSendResponse struct for BulkSendJobSendResponse
+type BulkSendJobSendResponse struct {
+	BulkSendJob BulkSendJobResponse `json:"bulk_send_job"`
+	Warnings    []WarningResponse   `json:"warnings,omitempty"` // A list of warnings.
+}
+
+// BulkSendJobResponse Contains information about the BulkSendJob such as when it was created and how many signature requests are queued.
+type BulkSendJobResponse struct {
+	// The id of the BulkSendJob.
+	BulkSendJobId string `json:"bulk_send_job_id,omitempty"`
+	// The total amount of Signature Requests queued for sending.
+	Total int `json:"total,omitempty"`
+	// True if you are the owner of this BulkSendJob, false if it's been shared with you by a team member.
+	IsCreator bool `json:"is_creator,omitempty"`
+	// Time that the BulkSendJob was created.
+	CreatedAt *UnixTimestamp `json:"created_at,omitempty"`
+}
+
+// GetBulkSendJobRequest holds the query parameters of the bulk send job endpoint, selecting the page of the
+// signature requests of the job to return
+type GetBulkSendJobRequest struct {
+	// Which page number of the BulkSendJob's signature requests to return. Defaults to `1`.
+	Page int `json:"page,omitempty"`
+	// Number of objects to be returned per page. Must be between `1` and `100`. Default is `20`.
+	PageSize int `json:"page_size,omitempty"`
+}
+
+// BulkSendJobGetResponse models the response from the bulk send job endpoint
+type BulkSendJobGetResponse struct {
+	BulkSendJob BulkSendJobResponse `json:"bulk_send_job"`
+	// Contains pagination information about the data returned.
+	ListInfo ListInfoResponse `json:"list_info"`
+	// Contains information about the Signature Requests sent in bulk.
+	SignatureRequests []SignatureRequestResponse `json:"signature_requests"`
+	// A list of warnings.
+	Warnings []WarningResponse `json:"warnings,omitempty"`
+}
+
+// ListBulkSendJobsRequest holds the query parameters of the bulk send job list endpoint
+type ListBulkSendJobsRequest struct {
+	// Which page number of the BulkSendJob List to return. Defaults to `1`.
+	Page int `json:"page,omitempty"`
+	// Number of objects to be returned per page. Must be between `1` and `100`. Default is `20`.
+	PageSize int `json:"page_size,omitempty"`
+}
+
+// BulkSendJobListResponse models the response from the bulk send job list endpoint
+type BulkSendJobListResponse struct {
+	// Contains a list of BulkSendJobs that the API caller has access to.
+	BulkSendJobs []BulkSendJobResponse `json:"bulk_send_jobs"`
+	// Contains pagination information about the data returned.
+	ListInfo ListInfoResponse `json:"list_info"`
+	// A list of warnings.
+	Warnings []WarningResponse `json:"warnings,omitempty"`
+}
@@ -0,0 +1,67 @@
+package model
+
+// CreateEmbeddedRequest struct for CreateEmbeddedRequest
+type CreateEmbeddedRequest struct {
+	// Client id of the app you're using to create this embedded signature request. Used for security purposes.
+	ClientId string `json:"client_id"`
+	// Use `files[]` to indicate the uploaded file(s) to send for signature.  This endpoint requires either **files** or **file_urls[]**,
+	// but not both.
+	Files []*File `json:"files,omitempty"`
+	// Use `file_urls[]` to have Dropbox Sign download the file(s) to send for signature.  This endpoint requires either **files** or
+	// **file_urls[]**, but not both.
+	FileUrls []string `json:"file_urls,omitempty"`
+	// Add Signers to your Signature Request.  This endpoint requires either **signers** or **grouped_signers**, but not both.
+	Signers []SubSignatureRequestSigner `json:"signers,omitempty"`
+	// Add Grouped Signers to your Signature Request.  This endpoint requires either **signers** or **grouped_signers**, but not both.
+	GroupedSigners []SubSignatureRequestGroupedSigners `json:"grouped_signers,omitempty"`
+	// Allows signers to decline to sign a document if `true`. Defaults to `false`.
+	AllowDecline bool `json:"allow_decline,omitempty"`
+	// Allows signers to reassign their signature requests to other signers if set to `true`. Defaults to `false`.  **NOTE:** Only
+	// available for Premium plan.
+	AllowReassign bool `json:"allow_reassign,omitempty"`
+	// A list describing the attachments
+	Attachments []SubAttachment `json:"attachments,omitempty"`
+	// The email addresses that should be CCed.
+	CCEmailAddresses []string `json:"cc_email_addresses,omitempty"`
+	// When used together with merge fields, `custom_fields` allows users to add pre-filled data to their signature requests.
+	CustomFields []SubCustomField `json:"custom_fields,omitempty"`
+	// This allows the requester to specify field options for a signature request.
+	FieldOptions *SubFieldOptions `json:"field_options,omitempty"`
+	// Group information for fields defined in `form_fields_per_document`. String-indexed JSON array with `group_label` and `requirement`
+	// keys. `form_fields_per_document` must contain fields referencing a group defined in `form_field_groups`.
+	FormFieldGroups []SubFormFieldGroup `json:"form_field_groups,omitempty"`
+	// Conditional Logic rules for fields defined in `form_fields_per_document`.
+	FormFieldRules []SubFormFieldRule `json:"form_field_rules,omitempty"`
+	// The fields that should appear on the document, expressed as an array of objects.  **NOTE:** Fields like **text**, **dropdown**,
+	// **checkbox**, **radio**, and **hyperlink** have additional required and optional parameters. Check out the list of [additional
+	// parameters](/api/reference/constants/#field-types) for these field types.
+	FormFieldsPerDocument []SubFormFieldsPerDocument `json:"form_fields_per_document,omitempty"`
+	// Enables automatic Text Tag removal when set to true.  **NOTE:** Removing text tags this way can cause unwanted clipping. We
+	// recommend leaving this setting on `false` and instead hiding your text tags using white text or a similar approach.
+	HideTextTags bool `json:"hide_text_tags,omitempty"`
+	// The custom message in the email that will be sent to the signers.
+	Message string `json:"message,omitempty"`
+	// Key-value data that should be attached to the signature request. This metadata is included in all API responses and events involving
+	// the signature request. For example, use the metadata field to store a signer's order number for look up when receiving events for the
+	// signature request.  Each request can include up to 10 metadata keys (or 50 nested metadata keys), with key names up to 40 characters
+	// long and values up to 1000 characters long.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Controls whether [auto fill fields](https://faq.hellosign.com/hc/en-us/articles/360051467511-Auto-Fill-Fields) can automatically
+	// populate a signer's information during signing.  **NOTE:** Keep your signer's information safe by ensuring that the _signer on
+	// your signature request is the intended party_ before using this feature.
+	PopulateAutoFillFields bool `json:"populate_auto_fill_fields,omitempty"`
+	// This allows the requester to specify the types allowed for creating a signature.
+	SigningOptions *SubSigningOptions `json:"signing_options,omitempty"`
+	// The subject in the email that will be sent to the signers.
+	Subject string `json:"subject,omitempty"`
+	// Whether this is a test, the signature request will not be legally binding if set to `true`. Defaults to `false`.
+	TestMode bool `json:"test_mode,omitempty"`
+	// The title you want to assign to the SignatureRequest.
+	Title string `json:"title,omitempty"`
+	// Send with a value of `true` if you wish to enable [Text Tags](https://app.hellosign.com/api/textTagsWalkthrough#TextTagIntro)
+	// parsing in your document. Defaults to disabled, or `false`.
+	UseTextTags bool `json:"use_text_tags,omitempty"`
+	// When the signature request will expire. Unsigned signatures will be moved to the expired status, and no longer signable. See
+	// [Signature Request Expiration Date](https://developers.hellosign.com/docs/signature-request/expiration/) for details.
+	ExpiresAt *UnixTimestamp `json:"expires_at,omitempty"`
+}
@@ -1,9 +1,5 @@
 package model
 
-import (
-	"os"
-)
-
 // CreateEmbeddedWithTemplateRequest struct for CreateEmbeddedWithTemplateRequest
 type CreateEmbeddedWithTemplateRequest struct {
 	// Client id of the app you're using to create this embedded signature request. Used for security purposes.
@@ -20,7 +16,7 @@ type CreateEmbeddedWithTemplateRequest struct {
 	CustomFields []SubCustomField `json:"custom_fields,omitempty"`
 	// Use `files[]` to indicate the uploaded file(s) to send for signature.  This endpoint requires either **files** or **file_urls[]**,
 	// but not both.
-	Files []*os.File `json:"files,omitempty"`
+	Files []*File `json:"files,omitempty"`
 	// Use `file_urls[]` to have Dropbox Sign download the file(s) to send for signature.  This endpoint requires either **files** or
 	// **file_urls[]**, but not both.
 	FileUrls []string `json:"file_urls,omitempty"`
@@ -0,0 +1,66 @@
+package model
+
+// EmbeddedEditUrlRequest struct for EmbeddedEditUrlRequest
+type EmbeddedEditUrlRequest struct {
+	// This allows the requester to enable/disable to add or change CC roles when editing the template.
+	AllowEditCcs bool `json:"allow_edit_ccs,omitempty"`
+	// The CC roles that must be assigned when using the template to send a signature request. To remove all CC roles, pass in an
+	// empty array, i.e. a pointer to an empty slice.  Left unchanged if nil.
+	CCRoles *[]string `json:"cc_roles,omitempty"`
+	// This allows the requester to specify editor options when a preparing a document
+	EditorOptions *SubEditorOptions `json:"editor_options,omitempty"`
+	// Provide users the ability to review/edit the template signer roles.
+	ForceSignerRoles bool `json:"force_signer_roles,omitempty"`
+	// Provide users the ability to review/edit the template subject and message.
+	ForceSubjectMessage bool `json:"force_subject_message,omitempty"`
+	// Add additional merge fields to the template, which can be used used to pre-fill data by passing values into signature requests
+	// made with that template.  Remove all merge fields on the template by passing an empty array `[]`, i.e. a pointer to an empty
+	// slice.  Left unchanged if nil.
+	MergeFields *[]SubMergeField `json:"merge_fields,omitempty"`
+	// This allows the requester to enable the preview experience (i.e. does not allow the requester's end user to add any additional
+	// fields via the editor).  **NOTE:** This parameter overwrites `show_preview=true` (if set).
+	PreviewOnly bool `json:"preview_only,omitempty"`
+	// This allows the requester to enable the editor/preview experience.
+	ShowPreview bool `json:"show_preview,omitempty"`
+	// When only one step remains in the signature request process and this parameter is set to `false` then the progress stepper will
+	// be hidden.  Defaults to `true`.
+	ShowProgressStepper *bool `json:"show_progress_stepper,omitempty"`
+	// Whether this is a test, locked templates will only be available for editing if this is set to `true`. Defaults to `false`.
+	TestMode bool `json:"test_mode,omitempty"`
+}
+
+// SubEditorOptions This allows the requester to specify editor options when a preparing a document
+type SubEditorOptions struct {
+	// Allows requesters to edit the list of signers
+	AllowEditSigners bool `json:"allow_edit_signers,omitempty"`
+	// Allows requesters to edit documents, including delete and add
+	AllowEditDocuments bool `json:"allow_edit_documents,omitempty"`
+}
+
+// Merge field types accepted in `merge_fields`.
+const (
+	MergeFieldTypeText     = "text"
+	MergeFieldTypeCheckbox = "checkbox"
+)
+
+// SubMergeField struct for SubMergeField
+type SubMergeField struct {
+	// The name of the merge field. Must be unique.
+	Name string `json:"name"`
+	// The type of merge field, one of the MergeFieldType constants.
+	Type string `json:"type"`
+}
+
+// EmbeddedEditUrlResponse struct for EmbeddedEditUrlResponse
+type EmbeddedEditUrlResponse struct {
+	Embedded EmbeddedEditUrlResponseEmbedded `json:"embedded"`
+	Warnings []WarningResponse               `json:"warnings,omitempty"` // A list of warnings.
+}
+
+// EmbeddedEditUrlResponseEmbedded An embedded template object.
+type EmbeddedEditUrlResponseEmbedded struct {
+	// A template url that can be opened in an iFrame.
+	EditURL string `json:"edit_url,omitempty"`
+	// The specific time that the the `edit_url` link expires, in epoch.
+	ExpiresAt *UnixTimestamp `json:"expires_at,omitempty"`
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"encoding/json"
+	"fmt"
 )
 
 // EventCallbackRequest struct for EventCallbackRequest
@@ -10,8 +11,46 @@ type EventCallbackRequest struct {
 	Event EventCallbackRequestEvent `json:"event"`
 	// Contains information about a signature request.
 	SignatureRequest *SignatureRequestResponse `json:"signature_request,omitempty"`
-	// Contains information about the accounts you and your team have created. (NOT IMPLEMENTED)
-	Account json.RawMessage `json:"account,omitempty"`
-	// Contains information about the templates you and your team have created. (NOT IMPLEMENTED)
-	Template json.RawMessage `json:"template,omitempty"`
+	// Contains information about an account and its settings.
+	Account *AccountResponse `json:"account,omitempty"`
+	// Contains information about the templates you and your team have created.
+	Template *TemplateResponse `json:"template,omitempty"`
+
+	// The undecoded `account` object, for accessing properties not modeled by Account.
+	RawAccount json.RawMessage `json:"-"`
+	// The undecoded `template` object, for accessing properties not modeled by Template.
+	RawTemplate json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON parses it from JSON, retaining the raw `account` and `template` objects.
+func (r *EventCallbackRequest) UnmarshalJSON(src []byte) error {
+	type plain EventCallbackRequest // has no UnmarshalJSON method
+	var aux struct {
+		plain
+		Account  json.RawMessage `json:"account,omitempty"`
+		Template json.RawMessage `json:"template,omitempty"`
+	}
+	if err := json.Unmarshal(src, &aux); err != nil {
+		return err
+	}
+	*r = EventCallbackRequest(aux.plain)
+
+	if isJSONValue(aux.Account) {
+		r.RawAccount = aux.Account
+		if err := json.Unmarshal(aux.Account, &r.Account); err != nil {
+			return fmt.Errorf("decoding account: %w", err)
+		}
+	}
+	if isJSONValue(aux.Template) {
+		r.RawTemplate = aux.Template
+		if err := json.Unmarshal(aux.Template, &r.Template); err != nil {
+			return fmt.Errorf("decoding template: %w", err)
+		}
+	}
+	return nil
+}
+
+// isJSONValue reports whether raw holds a value other than null
+func isJSONValue(raw json.RawMessage) bool {
+	return len(raw) > 0 && string(raw) != "null"
 }
@@ -1,15 +1,49 @@
 package model
 
+import (
+	"bytes"
+	"encoding/json"
+)
+
 // EventCallbackRequestEvent Basic information about the event that occurred.
 type EventCallbackRequestEvent struct {
 	// Time the event was created (using Unix time).
 	EventTime UnixTimestamp `json:"event_time"`
 	// Type of callback event that was triggered.
-	EventType string `json:"event_type"`
+	EventType EventType `json:"event_type"`
 	// Generated hash used to verify source of event data.
 	EventHash string `json:"event_hash"`
 	// Specific metadata about the event.
 	EventMetadata *EventCallbackRequestEventMetadata `json:"event_metadata,omitempty"`
+
+	// The `event_time` exactly as sent (unquoted if it was a string), which EventHash is computed from.
+	RawEventTime string `json:"-"`
+}
+
+// UnmarshalJSON parses it from JSON, retaining the text of `event_time`.
+func (e *EventCallbackRequestEvent) UnmarshalJSON(src []byte) error {
+	type plain EventCallbackRequestEvent // has no UnmarshalJSON method
+	var aux struct {
+		plain
+		EventTime json.RawMessage `json:"event_time"`
+	}
+	if err := json.Unmarshal(src, &aux); err != nil {
+		return err
+	}
+	*e = EventCallbackRequestEvent(aux.plain)
+
+	if isJSONValue(aux.EventTime) {
+		if err := json.Unmarshal(aux.EventTime, &e.EventTime); err != nil {
+			return err
+		}
+		e.RawEventTime = string(aux.EventTime)
+		if bytes.HasPrefix(aux.EventTime, []byte(`"`)) {
+			if err := json.Unmarshal(aux.EventTime, &e.RawEventTime); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // EventCallbackRequestEventMetadata Specific metadata about the event.
@@ -23,3 +23,63 @@ func TestEventCallbackRequestEvent(t *testing.T) {
 	expectedCreatedAt := time.Date(2024, time.October, 28, 18, 26, 37, 0, time.UTC).In(time.Local)
 	assert.Equal(t, expectedCreatedAt, actual.Event.EventTime.Time)
 }
+
+func TestEventCallbackRequestTemplate(t *testing.T) {
+	jsonBytes, err := os.ReadFile("testdata/template_created.json")
+	require.NoError(t, err)
+
+	var actual model.EventCallbackRequest
+	err = json.Unmarshal(jsonBytes, &actual)
+	require.NoError(t, err)
+
+	assert.Equal(t, model.EventTypeTemplateCreated, actual.Event.EventType)
+	assert.Nil(t, actual.SignatureRequest)
+	assert.Nil(t, actual.Account)
+	assert.Nil(t, actual.RawAccount)
+	require.NotNil(t, actual.Template)
+	assert.Equal(t, "cccc6ad681229567aab20cd83a69cf18fb2cccc", actual.Template.TemplateId)
+	assert.Equal(t, []model.TemplateResponseSignerRole{{Name: "First", Order: new(int)}}, actual.Template.SignerRoles)
+	assert.Equal(t, "Manager", actual.Template.CCRoles[0].Name)
+	require.Len(t, actual.Template.Documents, 1)
+	assert.Equal(t, model.StringOrInt("1"), actual.Template.Documents[0].FormFields[0].Signer)
+	assert.Equal(t, model.StringOrInt("sender"), actual.Template.Documents[0].CustomFields[0].Signer)
+	require.Len(t, actual.Template.Accounts, 1)
+	assert.Equal(t, 4, *actual.Template.Accounts[0].Quotas.TemplatesLeft)
+	assert.Nil(t, actual.Template.Accounts[0].Quotas.DocumentsLeft)
+
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(actual.RawTemplate, &raw))
+	assert.Equal(t, "Agreement - Medical", raw["title"])
+}
+
+func TestEventCallbackRequestAccount(t *testing.T) {
+	jsonBytes, err := os.ReadFile("testdata/account_confirmed.json")
+	require.NoError(t, err)
+
+	var actual model.EventCallbackRequest
+	err = json.Unmarshal(jsonBytes, &actual)
+	require.NoError(t, err)
+
+	assert.Equal(t, model.EventTypeAccountConfirmed, actual.Event.EventType)
+	assert.Nil(t, actual.SignatureRequest)
+	assert.Nil(t, actual.Template)
+	assert.Nil(t, actual.RawTemplate)
+	require.NotNil(t, actual.Account)
+	assert.Equal(t, "bbbb7b4b3d2c3b0a9f8e7d6c5b4a3f2e1d0cbbbb", actual.Account.AccountId)
+	assert.Equal(t, "jack@example.com", actual.Account.EmailAddress)
+	assert.True(t, actual.Account.IsPaidHs)
+	assert.False(t, actual.Account.IsLocked)
+	assert.Equal(t, "https://example.com/callback", actual.Account.CallbackUrl)
+	assert.Equal(t, "a8c2b1d4e6f3a9b7c5d2e0f1a3b6c8d9e7f4a2b1", actual.Account.TeamId)
+	assert.Equal(t, "en-US", actual.Account.Locale)
+	require.NotNil(t, actual.Account.Quotas)
+	assert.Equal(t, 1250, *actual.Account.Quotas.ApiSignatureRequestsLeft)
+	assert.Nil(t, actual.Account.Quotas.DocumentsLeft)
+	assert.Equal(t, 3, *actual.Account.Quotas.TemplatesLeft)
+	require.NotNil(t, actual.Account.Usage)
+	assert.Equal(t, 0, *actual.Account.Usage.FaxPages)
+
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(actual.RawAccount, &raw))
+	assert.Equal(t, false, raw["is_test_account"])
+}
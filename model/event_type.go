@@ -0,0 +1,93 @@
+package model
+
+// EventType is the type of callback event that was triggered.
+type EventType string
+
+// Event types of callback events sent by Dropbox Sign.
+const (
+	// The signature request has been sent to its signers.
+	EventTypeSignatureRequestSent EventType = "signature_request_sent"
+	// A signer viewed the signature request.
+	EventTypeSignatureRequestViewed EventType = "signature_request_viewed"
+	// A signer completed their signature.
+	EventTypeSignatureRequestSigned EventType = "signature_request_signed"
+	// A signer declined the signature request.
+	EventTypeSignatureRequestDeclined EventType = "signature_request_declined"
+	// A signer reassigned the signature request to someone else.
+	EventTypeSignatureRequestReassigned EventType = "signature_request_reassigned"
+	// A reminder was sent to a signer.
+	EventTypeSignatureRequestRemind EventType = "signature_request_remind"
+	// All signers have completed signing.
+	EventTypeSignatureRequestAllSigned EventType = "signature_request_all_signed"
+	// The email sent to a signer bounced.
+	EventTypeSignatureRequestEmailBounce EventType = "signature_request_email_bounce"
+	// The signature request could not be processed, e.g. because of invalid text tags.
+	EventTypeSignatureRequestInvalid EventType = "signature_request_invalid"
+	// The signature request was canceled by the requester.
+	EventTypeSignatureRequestCanceled EventType = "signature_request_canceled"
+	// The documents of the signature request have been prepared and it is ready to be signed.
+	EventTypeSignatureRequestPrepared EventType = "signature_request_prepared"
+	// The signature request expired before all signers completed it.
+	EventTypeSignatureRequestExpired EventType = "signature_request_expired"
+	// The signed documents are available to download.
+	EventTypeSignatureRequestDownloadable EventType = "signature_request_downloadable"
+	// An error occurred while processing the uploaded files.
+	EventTypeFileError EventType = "file_error"
+	// An unknown error occurred while processing the signature request.
+	EventTypeUnknownError EventType = "unknown_error"
+	// The sign url of an embedded signature request was invalid when opened.
+	EventTypeSignUrlInvalid EventType = "sign_url_invalid"
+	// An account created through the API app confirmed its email address.
+	EventTypeAccountConfirmed EventType = "account_confirmed"
+	// A template was created.
+	EventTypeTemplateCreated EventType = "template_created"
+	// An error occurred while creating a template.
+	EventTypeTemplateError EventType = "template_error"
+	// A bulk send job finished sending all its signature requests.
+	EventTypeBulkJobCompleted EventType = "bulk_job_completed"
+	// Sent when the callback URL is tested from the API settings page or app settings.
+	EventTypeCallbackTest EventType = "callback_test"
+)
+
+// IsTerminal reports whether the event marks the end of a signature request's lifecycle, i.e. no further signing
+// activity will occur for it.
+func (t EventType) IsTerminal() bool {
+	switch t {
+	case EventTypeSignatureRequestAllSigned,
+		EventTypeSignatureRequestDeclined,
+		EventTypeSignatureRequestCanceled,
+		EventTypeSignatureRequestExpired,
+		EventTypeSignatureRequestInvalid:
+		return true
+	}
+	return false
+}
+
+// IsError reports whether the event reports a failure.  Such events usually carry an `event_message` in their metadata.
+func (t EventType) IsError() bool {
+	switch t {
+	case EventTypeSignatureRequestEmailBounce,
+		EventTypeSignatureRequestInvalid,
+		EventTypeFileError,
+		EventTypeUnknownError,
+		EventTypeSignUrlInvalid,
+		EventTypeTemplateError:
+		return true
+	}
+	return false
+}
+
+// IsSignerScoped reports whether the event concerns a single signer, identified by the `related_signature_id`
+// in its metadata.
+func (t EventType) IsSignerScoped() bool {
+	switch t {
+	case EventTypeSignatureRequestViewed,
+		EventTypeSignatureRequestSigned,
+		EventTypeSignatureRequestDeclined,
+		EventTypeSignatureRequestReassigned,
+		EventTypeSignatureRequestRemind,
+		EventTypeSignatureRequestEmailBounce:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// File is a file uploaded with a request, such as the `files[]` of a signature request.  Requests carrying files are
+// sent as multipart/form-data; the contents are streamed from their source when the request is sent.
+type File struct {
+	// Name of the file sent to the API.  Its extension is how the API determines the file type.
+	Name string
+	// MIME type of the file.  If empty, it is determined from the extension of Name.
+	ContentType string
+
+	open       func() (io.ReadCloser, error) // opens the contents of the file
+	replayable bool                          // whether open may be called more than once
+}
+
+// FileFromReader creates a File whose contents are read from r.  Its contents can only be read once, so requests
+// carrying it cannot be retried.
+func FileFromReader(name string, r io.Reader) *File {
+	used := false
+	return &File{
+		Name: name,
+		open: func() (io.ReadCloser, error) {
+			if used {
+				return nil, errors.New("file " + name + " has already been read")
+			}
+			used = true
+			return io.NopCloser(r), nil
+		},
+	}
+}
+
+// FileFromBytes creates a File with the given contents.
+func FileFromBytes(name string, data []byte) *File {
+	return &File{
+		Name:       name,
+		open:       func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(data)), nil },
+		replayable: true,
+	}
+}
+
+// FileFromPath creates a File whose contents are read from the file at filePath, named after its base name.
+func FileFromPath(filePath string) *File {
+	return &File{
+		Name:       filepath.Base(filePath),
+		open:       func() (io.ReadCloser, error) { return os.Open(filePath) },
+		replayable: true,
+	}
+}
+
+// FileFromFS creates a File whose contents are read from the file with the given name in fsys, named after its
+// base name.
+func FileFromFS(fsys fs.FS, name string) *File {
+	return &File{
+		Name:       path.Base(name),
+		open:       func() (io.ReadCloser, error) { return fsys.Open(name) },
+		replayable: true,
+	}
+}
+
+// Open opens the contents of the file for reading.
+func (f *File) Open() (io.ReadCloser, error) {
+	if f.open == nil {
+		return nil, errors.New("file " + f.Name + " has no contents")
+	}
+	return f.open()
+}
+
+// Replayable reports whether the contents of the file can be read more than once.
+func (f *File) Replayable() bool {
+	return f.replayable
+}
+
+// MarshalJSON always fails: files cannot be sent in a JSON request body, only as multipart/form-data.
+func (f *File) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("file " + f.Name + " cannot be encoded as JSON")
+}
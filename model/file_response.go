@@ -0,0 +1,50 @@
+package model
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// FileResponse models the response of the `files_as_file_url` endpoints
+type FileResponse struct {
+	// URL to the file.
+	FileUrl string `json:"file_url"`
+	// When the link expires.
+	ExpiresAt *UnixTimestamp `json:"expires_at,omitempty"`
+}
+
+// FileResponseDataUri models the response of the `files_as_data_uri` endpoints
+type FileResponseDataUri struct {
+	// File as base64 encoded string.
+	DataUri string `json:"data_uri"`
+}
+
+// Decode returns the contents and MIME type of the file encoded in DataUri, e.g. "application/pdf".
+func (r FileResponseDataUri) Decode() (data []byte, mimeType string, err error) {
+	rest, ok := strings.CutPrefix(r.DataUri, "data:")
+	if !ok {
+		return nil, "", errors.New("data_uri does not start with data:")
+	}
+	meta, payload, ok := strings.Cut(rest, ",")
+	if !ok {
+		return nil, "", errors.New("data_uri has no data")
+	}
+
+	mimeType, isBase64 := strings.CutSuffix(meta, ";base64")
+	if mimeType == "" {
+		mimeType = "text/plain;charset=US-ASCII"
+	}
+	if isBase64 {
+		data, err = base64.StdEncoding.DecodeString(payload)
+	} else {
+		var unescaped string
+		unescaped, err = url.PathUnescape(payload)
+		data = []byte(unescaped)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return data, mimeType, nil
+}
@@ -0,0 +1,27 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileResponseDataUriDecode(t *testing.T) {
+	data, mimeType, err := model.FileResponseDataUri{DataUri: "data:application/pdf;base64,JVBERi0xLjQ="}.Decode()
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", mimeType)
+	assert.Equal(t, "%PDF-1.4", string(data))
+
+	data, mimeType, err = model.FileResponseDataUri{DataUri: "data:,Hello%2C%20World"}.Decode()
+	require.NoError(t, err)
+	assert.Equal(t, "text/plain;charset=US-ASCII", mimeType)
+	assert.Equal(t, "Hello, World", string(data))
+
+	_, _, err = model.FileResponseDataUri{DataUri: "https://example.org/file.pdf"}.Decode()
+	assert.Error(t, err)
+	_, _, err = model.FileResponseDataUri{DataUri: "data:application/pdf;base64,!!!"}.Decode()
+	assert.Error(t, err)
+}
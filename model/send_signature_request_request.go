@@ -0,0 +1,157 @@
+package model
+
+// SendSignatureRequestRequest struct for SendSignatureRequestRequest
+type SendSignatureRequestRequest struct {
+	// Use `files[]` to indicate the uploaded file(s) to send for signature.  This endpoint requires either **files** or **file_urls[]**,
+	// but not both.
+	Files []*File `json:"files,omitempty"`
+	// Use `file_urls[]` to have Dropbox Sign download the file(s) to send for signature.  This endpoint requires either **files** or
+	// **file_urls[]**, but not both.
+	FileUrls []string `json:"file_urls,omitempty"`
+	// Add Signers to your Signature Request.  This endpoint requires either **signers** or **grouped_signers**, but not both.
+	Signers []SubSignatureRequestSigner `json:"signers,omitempty"`
+	// Add Grouped Signers to your Signature Request.  This endpoint requires either **signers** or **grouped_signers**, but not both.
+	GroupedSigners []SubSignatureRequestGroupedSigners `json:"grouped_signers,omitempty"`
+	// Allows signers to decline to sign a document if `true`. Defaults to `false`.
+	AllowDecline bool `json:"allow_decline,omitempty"`
+	// Allows signers to reassign their signature requests to other signers if set to `true`. Defaults to `false`.  **NOTE:** Only
+	// available for Premium plan.
+	AllowReassign bool `json:"allow_reassign,omitempty"`
+	// A list describing the attachments
+	Attachments []SubAttachment `json:"attachments,omitempty"`
+	// The email addresses that should be CCed.
+	CCEmailAddresses []string `json:"cc_email_addresses,omitempty"`
+	// The client id of the API App you want to associate with this request. Used to apply the branding and callback url defined for
+	// the app.
+	ClientId string `json:"client_id,omitempty"`
+	// When used together with merge fields, `custom_fields` allows users to add pre-filled data to their signature requests.
+	CustomFields []SubCustomField `json:"custom_fields,omitempty"`
+	// This allows the requester to specify field options for a signature request.
+	FieldOptions *SubFieldOptions `json:"field_options,omitempty"`
+	// Group information for fields defined in `form_fields_per_document`. String-indexed JSON array with `group_label` and `requirement`
+	// keys. `form_fields_per_document` must contain fields referencing a group defined in `form_field_groups`.
+	FormFieldGroups []SubFormFieldGroup `json:"form_field_groups,omitempty"`
+	// Conditional Logic rules for fields defined in `form_fields_per_document`.
+	FormFieldRules []SubFormFieldRule `json:"form_field_rules,omitempty"`
+	// The fields that should appear on the document, expressed as an array of objects.  **NOTE:** Fields like **text**, **dropdown**,
+	// **checkbox**, **radio**, and **hyperlink** have additional required and optional parameters. Check out the list of [additional
+	// parameters](/api/reference/constants/#field-types) for these field types.
+	FormFieldsPerDocument []SubFormFieldsPerDocument `json:"form_fields_per_document,omitempty"`
+	// Enables automatic Text Tag removal when set to true.  **NOTE:** Removing text tags this way can cause unwanted clipping. We
+	// recommend leaving this setting on `false` and instead hiding your text tags using white text or a similar approach.
+	HideTextTags bool `json:"hide_text_tags,omitempty"`
+	// The custom message in the email that will be sent to the signers.
+	Message string `json:"message,omitempty"`
+	// Key-value data that should be attached to the signature request. This metadata is included in all API responses and events involving
+	// the signature request. For example, use the metadata field to store a signer's order number for look up when receiving events for the
+	// signature request.  Each request can include up to 10 metadata keys (or 50 nested metadata keys), with key names up to 40 characters
+	// long and values up to 1000 characters long.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// This allows the requester to specify the types allowed for creating a signature.
+	SigningOptions *SubSigningOptions `json:"signing_options,omitempty"`
+	// The URL you want signers redirected to after they successfully sign.
+	SigningRedirectUrl string `json:"signing_redirect_url,omitempty"`
+	// The subject in the email that will be sent to the signers.
+	Subject string `json:"subject,omitempty"`
+	// Whether this is a test, the signature request will not be legally binding if set to `true`. Defaults to `false`.
+	TestMode bool `json:"test_mode,omitempty"`
+	// The title you want to assign to the SignatureRequest.
+	Title string `json:"title,omitempty"`
+	// Send with a value of `true` if you wish to enable [Text Tags](https://app.hellosign.com/api/textTagsWalkthrough#TextTagIntro)
+	// parsing in your document. Defaults to disabled, or `false`.
+	UseTextTags bool `json:"use_text_tags,omitempty"`
+	// When the signature request will expire. Unsigned signatures will be moved to the expired status, and no longer signable. See
+	// [Signature Request Expiration Date](https://developers.hellosign.com/docs/signature-request/expiration/) for details.
+	ExpiresAt *UnixTimestamp `json:"expires_at,omitempty"`
+}
+
+// SendWithTemplateRequest struct for SendWithTemplateRequest
+type SendWithTemplateRequest struct {
+	// Use `template_ids` to create a SignatureRequest from one or more templates, in the order in which the template will be used.
+	TemplateIds []string `json:"template_ids"`
+	// Add Signers to your Templated-based Signature Request.
+	Signers []SubSignatureRequestTemplateSigner `json:"signers"`
+	// Allows signers to decline to sign a document if `true`. Defaults to `false`.
+	AllowDecline bool `json:"allow_decline,omitempty"`
+	// Add CC email recipients. Required when a CC role exists for the Template.
+	CCs []SubCC `json:"ccs,omitempty"`
+	// Client id of the app to associate with the signature request. Used to apply the branding and callback url defined for the app.
+	ClientId string `json:"client_id,omitempty"`
+	// An array defining values and options for custom fields. Required when a custom field exists in the Template.
+	CustomFields []SubCustomField `json:"custom_fields,omitempty"`
+	// Use `files[]` to indicate the uploaded file(s) to send for signature.  This endpoint requires either **files** or **file_urls[]**,
+	// but not both.
+	Files []*File `json:"files,omitempty"`
+	// Use `file_urls[]` to have Dropbox Sign download the file(s) to send for signature.  This endpoint requires either **files** or
+	// **file_urls[]**, but not both.
+	FileUrls []string `json:"file_urls,omitempty"`
+	// The custom message in the email that will be sent to the signers.
+	Message string `json:"message,omitempty"`
+	// Key-value data that should be attached to the signature request. This metadata is included in all API responses and events involving
+	// the signature request. For example, use the metadata field to store a signer's order number for look up when receiving events for the
+	// signature request.  Each request can include up to 10 metadata keys (or 50 nested metadata keys), with key names up to 40 characters
+	// long and values up to 1000 characters long.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// This allows the requester to specify the types allowed for creating a signature.
+	SigningOptions *SubSigningOptions `json:"signing_options,omitempty"`
+	// The URL you want signers redirected to after they successfully sign.
+	SigningRedirectUrl string `json:"signing_redirect_url,omitempty"`
+	// The subject in the email that will be sent to the signers.
+	Subject string `json:"subject,omitempty"`
+	// Whether this is a test, the signature request will not be legally binding if set to `true`. Defaults to `false`.
+	TestMode bool `json:"test_mode,omitempty"`
+	// The title you want to assign to the SignatureRequest.
+	Title string `json:"title,omitempty"`
+}
+
+// SubSignatureRequestSigner struct for SubSignatureRequestSigner
+type SubSignatureRequestSigner struct {
+	// The name of the signer.
+	Name string `json:"name"`
+	// The email address of the signer.
+	EmailAddress string `json:"email_address"`
+	// The order the signer is required to sign in.
+	Order *int `json:"order,omitempty"`
+	// The 4- to 12-character access code that will secure this signer's signature page.
+	Pin string `json:"pin,omitempty"`
+	// An E.164 formatted phone number.  By using the feature, you agree you are responsible for obtaining a signer's consent to receive
+	// text messages from Dropbox Sign related to this signature request and confirm you have obtained such consent from all signers prior
+	// to enabling SMS delivery for this signature request. [Learn
+	// more](https://faq.hellosign.com/hc/en-us/articles/15815316468877-Dropbox-Sign-SMS-tools-add-on).  **NOTE:** Not available in test
+	// mode and requires a Standard plan or higher.
+	SmsPhoneNumber string `json:"sms_phone_number,omitempty"`
+	// Specifies the feature used with the `sms_phone_number`. Default `authentication`.  If `authentication`, signer is sent a verification
+	// code via SMS that is required to access the document.  If `delivery`, a link to complete the signature request is delivered via SMS
+	// (_and_ email).
+	SmsPhoneNumberType string `json:"sms_phone_number_type,omitempty"`
+}
+
+// SubAttachment struct for SubAttachment
+type SubAttachment struct {
+	// The name of attachment.
+	Name string `json:"name"`
+	// The signer's index in the `signers` parameter (0-based indexing).  **NOTE:** Only one signer can be assigned per attachment.
+	SignerIndex int `json:"signer_index"`
+	// The instructions for uploading the attachment.
+	Instructions string `json:"instructions,omitempty"`
+	// Determines if the attachment must be uploaded.
+	Required bool `json:"required,omitempty"`
+}
+
+// SubFieldOptions This allows the requester to specify field options for a signature request.
+type SubFieldOptions struct {
+	// Allows requester to specify the date format (see list of allowed [formats](/api/reference/constants/#date-formats))  **NOTE:**
+	// Only available for Premium and higher.
+	DateFormat string `json:"date_format"`
+}
+
+// SubSignatureRequestGroupedSigners struct for SubSignatureRequestGroupedSigners
+type SubSignatureRequestGroupedSigners struct {
+	// The name of the group.
+	Group string `json:"group"`
+	// Signers belonging to this Group.  **NOTE:** Only `name`, `email_address`, and `pin` are available to Grouped Signers. We will
+	// ignore all other properties, even though they are listed below.
+	Signers []SubSignatureRequestSigner `json:"signers"`
+	// The order the group is required to sign in. Use this instead of Signer-level `order`.
+	Order *int `json:"order,omitempty"`
+}
@@ -0,0 +1,36 @@
+package model
+
+// ListSignatureRequestsRequest holds the query parameters of the signature request list endpoint
+type ListSignatureRequestsRequest struct {
+	// Which account to return SignatureRequests for. Must be a team member. Use `all` to indicate all team members. Defaults to your
+	// account.
+	AccountId string `json:"account_id,omitempty"`
+	// Which page number of the SignatureRequest List to return. Defaults to `1`.
+	Page int `json:"page,omitempty"`
+	// Number of objects to be returned per page. Must be between `1` and `100`. Default is `20`.
+	PageSize int `json:"page_size,omitempty"`
+	// String that includes search terms and/or fields to be used to filter the SignatureRequest objects, e.g.
+	// `title:"Agreement" AND complete:true`.  See [Search](https://developers.hellosign.com/api/reference/search/) for details.
+	Query string `json:"query,omitempty"`
+}
+
+// SignatureRequestListResponse models the response from the signature request list endpoint
+type SignatureRequestListResponse struct {
+	// Contains information about signature requests.
+	SignatureRequests []SignatureRequestResponse `json:"signature_requests"`
+	// Pagination information for the data returned.
+	ListInfo ListInfoResponse  `json:"list_info"`
+	Warnings []WarningResponse `json:"warnings,omitempty"` // A list of warnings.
+}
+
+// ListInfoResponse Contains pagination information about the data returned.
+type ListInfoResponse struct {
+	// Total number of pages available.
+	NumPages int `json:"num_pages"`
+	// Total number of objects available.
+	NumResults int `json:"num_results"`
+	// Number of the page being returned.
+	Page int `json:"page"`
+	// Objects returned per page.
+	PageSize int `json:"page_size"`
+}
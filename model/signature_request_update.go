@@ -0,0 +1,23 @@
+package model
+
+// RemindSignatureRequestRequest struct for RemindSignatureRequestRequest
+type RemindSignatureRequestRequest struct {
+	// The email address of the signer to send a reminder to.
+	EmailAddress string `json:"email_address"`
+	// The name of the signer to send a reminder to. Include if two or more signers share an email address.
+	Name string `json:"name,omitempty"`
+}
+
+// UpdateSignatureRequestRequest struct for UpdateSignatureRequestRequest
+type UpdateSignatureRequestRequest struct {
+	// The signature ID for the recipient.
+	SignatureId string `json:"signature_id"`
+	// The new email address for the recipient.  This will generate a new `signature_id` value.  **NOTE:** Optional if `name` is
+	// provided.  Cannot be updated for embedded signature requests.
+	EmailAddress string `json:"email_address,omitempty"`
+	// The new name for the recipient.  **NOTE:** Optional if `email_address` is provided.
+	Name string `json:"name,omitempty"`
+	// The new time when the signature request will expire. Unsigned signatures will be moved to the expired status, and no longer
+	// signable.
+	ExpiresAt *UnixTimestamp `json:"expires_at,omitempty"`
+}
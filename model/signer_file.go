@@ -0,0 +1,368 @@
+package model
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Suffixes of the signer file columns holding the properties of the signer of a role, e.g. `Client_email_address`
+const (
+	signerColumnName           = "_name"
+	signerColumnEmailAddress   = "_email_address"
+	signerColumnPin            = "_pin"
+	signerColumnSmsPhoneNumber = "_sms_phone_number"
+)
+
+// signerColumnSuffixes lists the suffixes of signer columns, in the order they are written and matched
+var signerColumnSuffixes = []string{signerColumnName, signerColumnEmailAddress, signerColumnPin, signerColumnSmsPhoneNumber}
+
+// SignerFile is the contents of the CSV `signer_file` of a bulk send.  Each row is a signature request to send,
+// holding the signer of each template role and the values of the custom fields.  The header row of the CSV has
+// `<Role>_name`, `<Role>_email_address`, `<Role>_pin` and `<Role>_sms_phone_number` columns for each role, followed
+// by a column named after each custom field.
+type SignerFile struct {
+	Rows []SubBulkSignerList
+}
+
+// Add appends a row to the file, with the signers of each role and the values of the custom fields.  Only the Name
+// and Value of the custom fields are used.
+func (f *SignerFile) Add(signers []SubSignatureRequestTemplateSigner, customFields ...SubCustomField) {
+	row := SubBulkSignerList{Signers: signers}
+	for _, cf := range customFields {
+		row.CustomFields = append(row.CustomFields, SubBulkSignerListCustomField{Name: cf.Name, Value: cf.Value})
+	}
+	f.Rows = append(f.Rows, row)
+}
+
+// Roles returns the roles of the signers of the file, in the order they first appear.
+func (f *SignerFile) Roles() []string {
+	var roles []string
+	seen := make(map[string]bool)
+	for _, row := range f.Rows {
+		for _, s := range row.Signers {
+			if !seen[s.Role] {
+				seen[s.Role] = true
+				roles = append(roles, s.Role)
+			}
+		}
+	}
+	return roles
+}
+
+// CustomFieldNames returns the names of the custom fields of the file, in the order they first appear.
+func (f *SignerFile) CustomFieldNames() []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, row := range f.Rows {
+		for _, cf := range row.CustomFields {
+			if !seen[cf.Name] {
+				seen[cf.Name] = true
+				names = append(names, cf.Name)
+			}
+		}
+	}
+	return names
+}
+
+// Validate checks that every row has exactly one valid signer for each role of the file and at most one value for
+// each custom field, returning a *ValidationError listing all the problems found.
+func (f *SignerFile) Validate() error {
+	var v validator
+	if len(f.Rows) == 0 {
+		v.addf("rows", "is required")
+	}
+	roles := f.Roles()
+	columns := make(map[string]string) // column name -> what it holds, to detect collisions
+	for _, role := range roles {
+		for _, suffix := range signerColumnSuffixes {
+			columns[role+suffix] = fmt.Sprintf("role %q", role)
+		}
+	}
+	for _, name := range f.CustomFieldNames() {
+		if holder, ok := columns[name]; ok {
+			v.addf("custom_fields", "custom field %q has the same column name as a property of %s", name, holder)
+		}
+	}
+
+	for i, row := range f.Rows {
+		field := fmt.Sprintf("rows[%d]", i)
+		assigned := make(map[string]bool)
+		for j, s := range row.Signers {
+			sfield := fmt.Sprintf("%s.signers[%d]", field, j)
+			v.templateSigner(sfield, s)
+			if assigned[s.Role] {
+				v.addf(sfield+".role", "role %q already has a signer in this row", s.Role)
+			}
+			assigned[s.Role] = true
+		}
+		for _, role := range roles {
+			if !assigned[role] {
+				v.addf(field+".signers", "no signer for role %q", role)
+			}
+		}
+		names := make(map[string]bool)
+		for j, cf := range row.CustomFields {
+			cfield := fmt.Sprintf("%s.custom_fields[%d].name", field, j)
+			v.required(cfield, cf.Name)
+			if names[cf.Name] {
+				v.addf(cfield, "custom field %q already has a value in this row", cf.Name)
+			}
+			names[cf.Name] = true
+		}
+	}
+	return v.err()
+}
+
+// WriteCSV validates the file and writes it to w as CSV.  Pin and SMS phone number columns are only written for the
+// roles which use them.
+func (f *SignerFile) WriteCSV(w io.Writer) error {
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	type column struct {
+		role, suffix, customField string
+	}
+	var columns []column
+	var header []string
+	for _, role := range f.Roles() {
+		for _, suffix := range signerColumnSuffixes {
+			if (suffix == signerColumnPin || suffix == signerColumnSmsPhoneNumber) && !f.usesColumn(role, suffix) {
+				continue
+			}
+			columns = append(columns, column{role: role, suffix: suffix})
+			header = append(header, role+suffix)
+		}
+	}
+	for _, name := range f.CustomFieldNames() {
+		columns = append(columns, column{customField: name})
+		header = append(header, name)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	record := make([]string, len(columns))
+	for _, row := range f.Rows {
+		for i, col := range columns {
+			if col.customField != "" {
+				record[i] = row.customFieldValue(col.customField)
+			} else {
+				record[i] = signerColumnValue(row.signer(col.role), col.suffix)
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Bytes returns the file encoded as CSV, see WriteCSV.
+func (f *SignerFile) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := f.WriteCSV(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// File returns the file encoded as CSV as a File named name, to use as the `signer_file` of a bulk send request.
+func (f *SignerFile) File(name string) (*File, error) {
+	data, err := f.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	file := FileFromBytes(name, data)
+	file.ContentType = "text/csv"
+	return file, nil
+}
+
+// usesColumn reports whether a signer of role has a value for the property of the column with the given suffix
+func (f *SignerFile) usesColumn(role, suffix string) bool {
+	for _, row := range f.Rows {
+		if signerColumnValue(row.signer(role), suffix) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// signer returns the signer of role in the row, or nil if there is none
+func (r SubBulkSignerList) signer(role string) *SubSignatureRequestTemplateSigner {
+	for i := range r.Signers {
+		if r.Signers[i].Role == role {
+			return &r.Signers[i]
+		}
+	}
+	return nil
+}
+
+// customFieldValue returns the value of the named custom field in the row, or "" if there is none
+func (r SubBulkSignerList) customFieldValue(name string) string {
+	for _, cf := range r.CustomFields {
+		if cf.Name == name {
+			return cf.Value
+		}
+	}
+	return ""
+}
+
+// signerColumnValue returns the property of s held by the column with the given suffix
+func signerColumnValue(s *SubSignatureRequestTemplateSigner, suffix string) string {
+	if s == nil {
+		return ""
+	}
+	switch suffix {
+	case signerColumnName:
+		return s.Name
+	case signerColumnEmailAddress:
+		return s.EmailAddress
+	case signerColumnPin:
+		return s.Pin
+	default:
+		return s.SmsPhoneNumber
+	}
+}
+
+// setSignerColumnValue sets the property of s held by the column with the given suffix
+func setSignerColumnValue(s *SubSignatureRequestTemplateSigner, suffix, value string) {
+	switch suffix {
+	case signerColumnName:
+		s.Name = value
+	case signerColumnEmailAddress:
+		s.EmailAddress = value
+	case signerColumnPin:
+		s.Pin = value
+	default:
+		s.SmsPhoneNumber = value
+	}
+}
+
+// ParseSignerFile reads a signer file from CSV, such as one exported from a spreadsheet, and validates it.  Columns
+// are matched to roles by their suffix (e.g. `Client_email_address`), other columns being custom fields.  Without
+// roles, a column is only a signer column if its role also has both a `_name` and an `_email_address` column, so that
+// custom fields such as `company_name` are not mistaken for signers.  When roles are given, the columns prefixed by
+// one of them are signer columns, and no others.  Empty cells are ignored.
+func ParseSignerFile(r io.Reader, roles ...string) (*SignerFile, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, errors.New("signer file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading signer file header: %w", err)
+	}
+
+	type column struct {
+		role, suffix, customField string
+	}
+	names := make([]string, len(header))
+	seen := make(map[string]bool)
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff") // Byte order mark written by spreadsheets
+		}
+		if name == "" {
+			return nil, fmt.Errorf("signer file column %d has no name", i+1)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("signer file has several %q columns", name)
+		}
+		seen[name] = true
+		names[i] = name
+	}
+	columns := make([]column, len(header))
+	for i, name := range names {
+		columns[i] = column{customField: name}
+		role, suffix, ok := splitSignerColumn(name, roles)
+		if ok && (len(roles) > 0 || (seen[role+signerColumnName] && seen[role+signerColumnEmailAddress])) {
+			columns[i] = column{role: role, suffix: suffix}
+		}
+	}
+	for _, col := range columns {
+		if col.suffix == signerColumnName && !seen[col.role+signerColumnEmailAddress] {
+			return nil, fmt.Errorf("signer file has a %q column but no %q column", col.role+signerColumnName, col.role+signerColumnEmailAddress)
+		}
+		if col.suffix == signerColumnEmailAddress && !seen[col.role+signerColumnName] {
+			return nil, fmt.Errorf("signer file has a %q column but no %q column", col.role+signerColumnEmailAddress, col.role+signerColumnName)
+		}
+	}
+
+	f := new(SignerFile)
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading signer file: %w", err)
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+
+		var row SubBulkSignerList
+		signers := make(map[string]int) // role -> index in row.Signers
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			col := columns[i]
+			switch {
+			case value == "":
+			case col.customField != "":
+				row.CustomFields = append(row.CustomFields, SubBulkSignerListCustomField{Name: col.customField, Value: value})
+			default:
+				idx, ok := signers[col.role]
+				if !ok {
+					idx = len(row.Signers)
+					signers[col.role] = idx
+					row.Signers = append(row.Signers, SubSignatureRequestTemplateSigner{Role: col.role})
+				}
+				setSignerColumnValue(&row.Signers[idx], col.suffix, value)
+			}
+		}
+		f.Rows = append(f.Rows, row)
+	}
+
+	if err := f.Validate(); err != nil {
+		return f, err
+	}
+	return f, nil
+}
+
+// splitSignerColumn returns the role and suffix of a signer column name, restricted to the given roles if any
+func splitSignerColumn(name string, roles []string) (role, suffix string, ok bool) {
+	for _, suffix := range signerColumnSuffixes {
+		role, found := strings.CutSuffix(name, suffix)
+		if !found || role == "" {
+			continue
+		}
+		if len(roles) == 0 {
+			return role, suffix, true
+		}
+		for _, r := range roles {
+			if r == role {
+				return role, suffix, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// isBlankRecord reports whether all the cells of a CSV record are empty, as in trailing spreadsheet rows
+func isBlankRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
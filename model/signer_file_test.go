@@ -0,0 +1,116 @@
+package model_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignerFileWriteCSV(t *testing.T) {
+	var f model.SignerFile
+	f.Add([]model.SubSignatureRequestTemplateSigner{
+		{Role: "Client", Name: `George "Geo" Smith`, EmailAddress: "george@example.com", Pin: "1234"},
+		{Role: "Witness", Name: "Mary, Jr.", EmailAddress: "mary@example.com"},
+	}, model.SubCustomField{Name: "Company", Value: "ACME\nWidgets"})
+	f.Add([]model.SubSignatureRequestTemplateSigner{
+		{Role: "Witness", Name: "Bob", EmailAddress: "bob@example.com"},
+		{Role: "Client", Name: "Alice", EmailAddress: "alice@example.com"},
+	})
+
+	data, err := f.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "Client_name,Client_email_address,Client_pin,Witness_name,Witness_email_address,Company\n"+
+		`"George ""Geo"" Smith",george@example.com,1234,"Mary, Jr.",mary@example.com,"ACME`+"\n"+`Widgets"`+"\n"+
+		"Alice,alice@example.com,,Bob,bob@example.com,\n", string(data))
+
+	file, err := f.File("signers.csv")
+	require.NoError(t, err)
+	assert.Equal(t, "signers.csv", file.Name)
+	assert.Equal(t, "text/csv", file.ContentType)
+	assert.True(t, file.Replayable())
+	rc, err := file.Open()
+	require.NoError(t, err)
+	contents, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, data, contents)
+
+	parsed, err := model.ParseSignerFile(strings.NewReader(string(data)))
+	require.NoError(t, err)
+	assert.Equal(t, f.Rows[0], parsed.Rows[0])
+	assert.Equal(t, []string{"Client", "Witness"}, parsed.Roles())
+	assert.ElementsMatch(t, f.Rows[1].Signers, parsed.Rows[1].Signers)
+	assert.Empty(t, parsed.Rows[1].CustomFields)
+}
+
+func TestSignerFileValidate(t *testing.T) {
+	var f model.SignerFile
+	assert.Equal(t, []string{"rows"}, fieldErrors(t, f.Validate()))
+
+	f.Add([]model.SubSignatureRequestTemplateSigner{
+		{Role: "Client", Name: "Alice", EmailAddress: "alice@example.com", Pin: "12"},
+		{Role: "Client", Name: "Bob", EmailAddress: "bob@example.com"},
+	}, model.SubCustomField{Name: "Client_pin", Value: "x"})
+	f.Add([]model.SubSignatureRequestTemplateSigner{
+		{Role: "Witness", Name: "Mary"},
+	}, model.SubCustomField{Name: "Company", Value: "ACME"}, model.SubCustomField{Name: "Company", Value: "ACME"})
+
+	_, err := f.Bytes()
+	assert.Equal(t, []string{
+		"custom_fields",
+		"rows[0].signers[0].pin",
+		"rows[0].signers[1].role",
+		"rows[0].signers",
+		"rows[1].signers[0].email_address",
+		"rows[1].signers",
+		"rows[1].custom_fields[1].name",
+	}, fieldErrors(t, err))
+}
+
+func TestParseSignerFile(t *testing.T) {
+	csv := "\ufeffClient_name, Client_email_address,Client_sms_phone_number,Full_name\n" +
+		"Alice,alice@example.com,+14155550100,Alice Liddell\n" +
+		",,,\n"
+
+	f, err := model.ParseSignerFile(strings.NewReader(csv), "Client")
+	require.NoError(t, err)
+	assert.Equal(t, []model.SubBulkSignerList{{
+		Signers: []model.SubSignatureRequestTemplateSigner{
+			{Role: "Client", Name: "Alice", EmailAddress: "alice@example.com", SmsPhoneNumber: "+14155550100"},
+		},
+		CustomFields: []model.SubBulkSignerListCustomField{{Name: "Full_name", Value: "Alice Liddell"}},
+	}}, f.Rows)
+
+	// Without roles, suffixed columns are custom fields unless their role has name and email address columns
+	f, err = model.ParseSignerFile(strings.NewReader("Client_name,Client_email_address,company_name,Client_pin\n" +
+		"Alice,alice@example.com,ACME,1234\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []model.SubBulkSignerList{{
+		Signers: []model.SubSignatureRequestTemplateSigner{
+			{Role: "Client", Name: "Alice", EmailAddress: "alice@example.com", Pin: "1234"},
+		},
+		CustomFields: []model.SubBulkSignerListCustomField{{Name: "company_name", Value: "ACME"}},
+	}}, f.Rows)
+
+	// Given roles, their columns must come in pairs
+	_, err = model.ParseSignerFile(strings.NewReader("Client_name,Witness_name\nAlice,Bob\n"), "Client")
+	assert.EqualError(t, err, `signer file has a "Client_name" column but no "Client_email_address" column`)
+
+	_, err = model.ParseSignerFile(strings.NewReader(""))
+	assert.EqualError(t, err, "signer file is empty")
+
+	_, err = model.ParseSignerFile(strings.NewReader("Client_name,Client_name\n"))
+	assert.EqualError(t, err, `signer file has several "Client_name" columns`)
+
+	_, err = model.ParseSignerFile(strings.NewReader("Client_name,Client_email_address\nAlice\n"))
+	assert.ErrorContains(t, err, "reading signer file")
+
+	f, err = model.ParseSignerFile(strings.NewReader("Client_name,Client_email_address\nAlice,\n"))
+	assert.Equal(t, []string{"rows[0].signers[0].email_address"}, fieldErrors(t, err))
+	require.NotNil(t, f)
+	assert.Len(t, f.Rows, 1)
+}
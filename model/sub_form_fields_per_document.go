@@ -0,0 +1,136 @@
+package model
+
+// Form field types accepted in `form_fields_per_document`.  See [field types](/api/reference/constants/#field-types).
+const (
+	FormFieldTypeText          = "text"
+	FormFieldTypeCheckbox      = "checkbox"
+	FormFieldTypeRadio         = "radio"
+	FormFieldTypeSignature     = "signature"
+	FormFieldTypeInitials      = "initials"
+	FormFieldTypeDateSigned    = "date_signed"
+	FormFieldTypeDropdown      = "dropdown"
+	FormFieldTypeHyperlink     = "hyperlink"
+	FormFieldTypeTextMerge     = "text-merge"
+	FormFieldTypeCheckboxMerge = "checkbox-merge"
+)
+
+// SubFormFieldsPerDocument The fields that should appear on the document, expressed as an array of objects.  Which of the optional
+// properties apply depends on the `type` of the field, as noted for each property.
+type SubFormFieldsPerDocument struct {
+	// Represents the integer index of the `file` or `file_url` document the field should be attached to.
+	DocumentIndex int `json:"document_index"`
+	// An identifier for the field that is unique across all documents in the request.
+	ApiId string `json:"api_id"`
+	// Size of the field in pixels.
+	Height int `json:"height"`
+	// Whether this field is required.
+	Required bool `json:"required"`
+	// Signer index identified by the offset in the signers parameter (0-based indexing), indicating which signer should fill out the
+	// field.  **NOTE:** To set the value of the field as the preparer you must set this to `me_now`
+	Signer string `json:"signer"`
+	// The type of this form field, one of the FormFieldType constants. See [field types](/api/reference/constants/#field-types).
+	Type string `json:"type"`
+	// Size of the field in pixels.
+	Width int `json:"width"`
+	// Location coordinates of the field in pixels.
+	X int `json:"x"`
+	// Location coordinates of the field in pixels.
+	Y int `json:"y"`
+	// Display name for the field.
+	Name string `json:"name,omitempty"`
+	// Page in the document where the field should be placed (requires documents be PDF files).  - When the page number parameter is
+	// supplied, the API will use the new coordinate system. - Check out the differences between both [coordinate
+	// systems](https://faq.hellosign.com/hc/en-us/articles/217115577) and how to use them.
+	Page *int `json:"page,omitempty"`
+
+	// `checkbox` and `radio`: whether the field is initially checked.  Required for these types.
+	IsChecked *bool `json:"is_checked,omitempty"`
+	// `radio`: name of the group the field belongs to, required for radio fields.  `checkbox`: name of the checkbox group defined in
+	// `form_field_groups`, if any.
+	Group string `json:"group,omitempty"`
+	// `text` and `date_signed`: font family for the field's text.
+	FontFamily string `json:"font_family,omitempty"`
+	// `text`, `date_signed`, `dropdown` and `hyperlink`: font size for the field's text, between 7 and 49.
+	FontSize int `json:"font_size,omitempty"`
+	// `text`: placeholder value shown in the field.
+	Placeholder string `json:"placeholder,omitempty"`
+	// `text`: auto fill type for populating the field from the signer's information, e.g. `name`, `email_address` or `company`.
+	AutoFillType string `json:"auto_fill_type,omitempty"`
+	// `text`: link two or more text fields, which will then contain the same value.
+	LinkId string `json:"link_id,omitempty"`
+	// `text`: masks entered data. For more information see [Masking sensitive information](https://faq.hellosign.com/hc/en-us/articles/360040742811-Masking-sensitive-information).
+	Masked bool `json:"masked,omitempty"`
+	// `text`: validation applied to the entered value, e.g. `numbers_only`, `email_address`, `zip_code` or `custom_regex`.
+	ValidationType string `json:"validation_type,omitempty"`
+	// `text`: the regular expression entered values must match when `validation_type` is `custom_regex`.
+	ValidationCustomRegex string `json:"validation_custom_regex,omitempty"`
+	// `text`: the label shown to signers describing the format expected by `validation_custom_regex`.
+	ValidationCustomRegexFormatLabel string `json:"validation_custom_regex_format_label,omitempty"`
+	// `dropdown`: the options signers can select, required for dropdown fields.
+	Options []string `json:"options,omitempty"`
+	// `dropdown`: the option selected by default.  `hyperlink`: the link text, required for hyperlink fields.
+	Content string `json:"content,omitempty"`
+	// `hyperlink`: the URL the link points to, required for hyperlink fields.
+	ContentUrl string `json:"content_url,omitempty"`
+}
+
+// SubFormFieldGroup struct for SubFormFieldGroup
+type SubFormFieldGroup struct {
+	// ID of group. Use this to reference a specific group from the `group` value in `form_fields_per_document`.
+	GroupId string `json:"group_id"`
+	// Name of the group
+	GroupLabel string `json:"group_label"`
+	// Examples: `require_0-1` `require_1` `require_1-ormore`  - Check out the list of [acceptable `requirement` checkbox type
+	// values](/api/reference/constants/#checkbox-field-grouping). - Check out the list of [acceptable `requirement` radio type
+	// fields](/api/reference/constants/#radio-field-grouping). - Radio groups require **at least** two fields per group.
+	Requirement string `json:"requirement"`
+}
+
+// SubFormFieldRule struct for SubFormFieldRule
+type SubFormFieldRule struct {
+	// Must be unique across all defined rules.
+	Id string `json:"id"`
+	// Currently only `AND` is supported. Support for `OR` is being worked on.
+	TriggerOperator string `json:"trigger_operator"`
+	// An array of trigger definitions, the \"if this\" part of \"**if this**, then that\". Currently only a single trigger per rule is
+	// allowed.
+	Triggers []SubFormFieldRuleTrigger `json:"triggers"`
+	// An array of action definitions, the \"then that\" part of \"if this, **then that**\". Any number of actions may be attached to a
+	// single rule.
+	Actions []SubFormFieldRuleAction `json:"actions"`
+}
+
+// SubFormFieldRuleTrigger struct for SubFormFieldRuleTrigger
+type SubFormFieldRuleTrigger struct {
+	// Must reference the `api_id` of an existing field defined within `form_fields_per_document`. Trigger and action fields and groups
+	// must belong to the same signer.
+	Id string `json:"id"`
+	// Different field types allow different `operator` values: - Field type of **text**:   - **is**: exact match   - **not**: not exact
+	// match   - **match**: regular expression, without /. Example:     - OK `[a-zA-Z0-9]`     - Not OK `/[a-zA-Z0-9]/` - Field type of
+	// **dropdown**:   - **is**: exact match, single value   - **not**: not exact match, single value   - **any**: exact match, array of
+	// values.   - **none**: not exact match, array of values. - Field type of **checkbox**:   - **is**: exact match, single value   -
+	// **not**: not exact match, single value - Field type of **radio**:   - **is**: exact match, single value   - **not**: not exact
+	// match, single value
+	Operator string `json:"operator"`
+	// **value** or **values** is required, but not both.  The value to match against **operator**.  - When **operator** is one of the
+	// following, **value** must be `String`:   - `is`   - `not`   - `match`  Otherwise, - **checkbox**: When **type** of trigger is
+	// **checkbox**, **value** must be `0` or `1` - **radio**: When **type** of trigger is **radio**, **value** must be `1`
+	Value string `json:"value,omitempty"`
+	// **values** or **value** is required, but not both.  The values to match against **operator** when it is one of the following:  -
+	// `any` - `none`
+	Values []string `json:"values,omitempty"`
+}
+
+// SubFormFieldRuleAction struct for SubFormFieldRuleAction
+type SubFormFieldRuleAction struct {
+	// `true` to hide the target field when rule is satisfied, otherwise `false`.
+	Hidden bool `json:"hidden"`
+	// Either `change-field-visibility` or `change-group-visibility`.
+	Type string `json:"type"`
+	// **field_id** or **group_id** is required, but not both.  Must reference the `api_id` of an existing field defined within
+	// `form_fields_per_document`.  Cannot use with `group_id`. Trigger and action fields must belong to the same signer.
+	FieldId string `json:"field_id,omitempty"`
+	// **group_id** or **field_id** is required, but not both.  Must reference the ID of an existing group defined within
+	// `form_field_groups`.  Cannot use with `field_id`. Trigger and action fields and groups must belong to the same signer.
+	GroupId string `json:"group_id,omitempty"`
+}
@@ -0,0 +1,24 @@
+package model
+
+// ListTemplatesRequest holds the query parameters of the template list endpoint
+type ListTemplatesRequest struct {
+	// Which account to return Templates for. Must be a team member. Use `all` to indicate all team members. Defaults to your account.
+	AccountId string `json:"account_id,omitempty"`
+	// Which page number of the Template List to return. Defaults to `1`.
+	Page int `json:"page,omitempty"`
+	// Number of objects to be returned per page. Must be between `1` and `100`. Default is `20`.
+	PageSize int `json:"page_size,omitempty"`
+	// String that includes search terms and/or fields to be used to filter the Template objects, e.g. `title:"NDA"`.  See
+	// [Search](https://developers.hellosign.com/api/reference/search/) for details.
+	Query string `json:"query,omitempty"`
+}
+
+// TemplateListResponse models the response from the template list endpoint
+type TemplateListResponse struct {
+	// List of templates that the API caller has access to.
+	Templates []TemplateResponse `json:"templates"`
+	// Contains pagination information about the data returned.
+	ListInfo ListInfoResponse `json:"list_info"`
+	// A list of warnings.
+	Warnings []WarningResponse `json:"warnings,omitempty"`
+}
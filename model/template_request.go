@@ -0,0 +1,202 @@
+package model
+
+// SubTemplateRole struct for SubTemplateRole
+type SubTemplateRole struct {
+	// The role name of the signer that will be displayed when the template is used to create a signature request.
+	Name string `json:"name"`
+	// The order in which this signer role is required to sign.
+	Order *int `json:"order,omitempty"`
+}
+
+// TemplateCreateRequest struct for TemplateCreateRequest
+type TemplateCreateRequest struct {
+	// Use `files[]` to indicate the uploaded file(s) to use for the template.  This endpoint requires either **files** or
+	// **file_urls[]**, but not both.
+	Files []*File `json:"files,omitempty"`
+	// Use `file_urls[]` to have Dropbox Sign download the file(s) to use for the template.  This endpoint requires either **files** or
+	// **file_urls[]**, but not both.
+	FileUrls []string `json:"file_urls,omitempty"`
+	// The fields that should appear on the document, expressed as an array of objects.  **NOTE:** Fields like **text**, **dropdown**,
+	// **checkbox**, **radio**, and **hyperlink** have additional required and optional parameters. Check out the list of [additional
+	// parameters](/api/reference/constants/#field-types) for these field types.
+	FormFieldsPerDocument []SubFormFieldsPerDocument `json:"form_fields_per_document"`
+	// An array of the designated signer roles that must be specified when sending a SignatureRequest using this Template.
+	SignerRoles []SubTemplateRole `json:"signer_roles"`
+	// Allows signers to reassign their signature requests to other signers if set to `true`. Defaults to `false`.  **NOTE:** Only
+	// available for Premium plan and higher.
+	AllowReassign bool `json:"allow_reassign,omitempty"`
+	// A list describing the attachments
+	Attachments []SubAttachment `json:"attachments,omitempty"`
+	// The CC roles that must be assigned when using the template to send a signature request
+	CCRoles []string `json:"cc_roles,omitempty"`
+	// Client id of the app you're using to create this template.
+	ClientId string `json:"client_id,omitempty"`
+	// This allows the requester to specify field options for a signature request.
+	FieldOptions *SubFieldOptions `json:"field_options,omitempty"`
+	// Group information for fields defined in `form_fields_per_document`. String-indexed JSON array with `group_label` and `requirement`
+	// keys. `form_fields_per_document` must contain fields referencing a group defined in `form_field_groups`.
+	FormFieldGroups []SubFormFieldGroup `json:"form_field_groups,omitempty"`
+	// Conditional Logic rules for fields defined in `form_fields_per_document`.
+	FormFieldRules []SubFormFieldRule `json:"form_field_rules,omitempty"`
+	// Add merge fields to the template. Merge fields are placed by the user creating the template and used to pre-fill data by passing
+	// values into signature requests with the `custom_fields` parameter. If the signature request using that template *does not* pass
+	// a value into a merge field, then an empty field remains in the document.
+	MergeFields []SubMergeField `json:"merge_fields,omitempty"`
+	// The default template email message.
+	Message string `json:"message,omitempty"`
+	// Key-value data that should be attached to the template.  Each request can include up to 10 metadata keys (or 50 nested metadata
+	// keys), with key names up to 40 characters long and values up to 1000 characters long.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// The template title (alias).
+	Subject string `json:"subject,omitempty"`
+	// Whether this is a test, the signature request created from this draft will not be legally binding if set to `true`. Defaults to
+	// `false`.
+	TestMode bool `json:"test_mode,omitempty"`
+	// The title you want to assign to the template.
+	Title string `json:"title,omitempty"`
+	// Enable the detection of predefined PDF fields by setting the `use_preexisting_fields` to `true` (defaults to disabled, or `false`).
+	UsePreexistingFields bool `json:"use_preexisting_fields,omitempty"`
+}
+
+// TemplateCreateResponse struct for TemplateCreateResponse
+type TemplateCreateResponse struct {
+	Template TemplateCreateResponseTemplate `json:"template"`
+	Warnings []WarningResponse              `json:"warnings,omitempty"` // A list of warnings.
+}
+
+// TemplateCreateResponseTemplate Template object with parameters: `template_id`.
+type TemplateCreateResponseTemplate struct {
+	// The id of the Template.
+	TemplateId string `json:"template_id,omitempty"`
+}
+
+// TemplateCreateEmbeddedDraftRequest struct for TemplateCreateEmbeddedDraftRequest
+type TemplateCreateEmbeddedDraftRequest struct {
+	// Client id of the app you're using to create this draft. Used to apply the branding and callback url defined for the app.
+	ClientId string `json:"client_id"`
+	// Use `files[]` to indicate the uploaded file(s) to use for the template.  This endpoint requires either **files** or
+	// **file_urls[]**, but not both.
+	Files []*File `json:"files,omitempty"`
+	// Use `file_urls[]` to have Dropbox Sign download the file(s) to use for the template.  This endpoint requires either **files** or
+	// **file_urls[]**, but not both.
+	FileUrls []string `json:"file_urls,omitempty"`
+	// This allows the requester to specify whether the user is allowed to provide email addresses to CC when creating a template.
+	AllowCCs *bool `json:"allow_ccs,omitempty"`
+	// Allows signers to reassign their signature requests to other signers if set to `true`. Defaults to `false`.  **NOTE:** Only
+	// available for Premium plan and higher.
+	AllowReassign bool `json:"allow_reassign,omitempty"`
+	// A list describing the attachments
+	Attachments []SubAttachment `json:"attachments,omitempty"`
+	// The CC roles that must be assigned when using the template to send a signature request
+	CCRoles []string `json:"cc_roles,omitempty"`
+	// This allows the requester to specify editor options when a preparing a document
+	EditorOptions *SubEditorOptions `json:"editor_options,omitempty"`
+	// This allows the requester to specify field options for a signature request.
+	FieldOptions *SubFieldOptions `json:"field_options,omitempty"`
+	// Provide users the ability to review/edit the template signer roles.
+	ForceSignerRoles bool `json:"force_signer_roles,omitempty"`
+	// Provide users the ability to review/edit the template subject and message.
+	ForceSubjectMessage bool `json:"force_subject_message,omitempty"`
+	// Group information for fields defined in `form_fields_per_document`. String-indexed JSON array with `group_label` and `requirement`
+	// keys. `form_fields_per_document` must contain fields referencing a group defined in `form_field_groups`.
+	FormFieldGroups []SubFormFieldGroup `json:"form_field_groups,omitempty"`
+	// Conditional Logic rules for fields defined in `form_fields_per_document`.
+	FormFieldRules []SubFormFieldRule `json:"form_field_rules,omitempty"`
+	// The fields that should appear on the document, expressed as an array of objects.  **NOTE:** Fields like **text**, **dropdown**,
+	// **checkbox**, **radio**, and **hyperlink** have additional required and optional parameters. Check out the list of [additional
+	// parameters](/api/reference/constants/#field-types) for these field types.
+	FormFieldsPerDocument []SubFormFieldsPerDocument `json:"form_fields_per_document,omitempty"`
+	// Add merge fields to the template. Merge fields are placed by the user creating the template and used to pre-fill data by passing
+	// values into signature requests with the `custom_fields` parameter. If the signature request using that template *does not* pass
+	// a value into a merge field, then an empty field remains in the document.
+	MergeFields []SubMergeField `json:"merge_fields,omitempty"`
+	// The default template email message.
+	Message string `json:"message,omitempty"`
+	// Key-value data that should be attached to the template.  Each request can include up to 10 metadata keys (or 50 nested metadata
+	// keys), with key names up to 40 characters long and values up to 1000 characters long.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// This allows the requester to enable the editor/preview experience.
+	ShowPreview bool `json:"show_preview,omitempty"`
+	// When only one step remains in the signature request process and this parameter is set to `false` then the progress stepper will
+	// be hidden.  Defaults to `true`.
+	ShowProgressStepper *bool `json:"show_progress_stepper,omitempty"`
+	// An array of the designated signer roles that must be specified when sending a SignatureRequest using this Template.
+	SignerRoles []SubTemplateRole `json:"signer_roles,omitempty"`
+	// Disables the "Me (Now)" option for the person preparing the document. Does not work with type `send_document`. Defaults to `false`.
+	SkipMeNow bool `json:"skip_me_now,omitempty"`
+	// The template title (alias).
+	Subject string `json:"subject,omitempty"`
+	// Whether this is a test, the signature request created from this draft will not be legally binding if set to `true`. Defaults to
+	// `false`.
+	TestMode bool `json:"test_mode,omitempty"`
+	// The title you want to assign to the template.
+	Title string `json:"title,omitempty"`
+	// Enable the detection of predefined PDF fields by setting the `use_preexisting_fields` to `true` (defaults to disabled, or `false`).
+	UsePreexistingFields bool `json:"use_preexisting_fields,omitempty"`
+}
+
+// TemplateCreateEmbeddedDraftResponse struct for TemplateCreateEmbeddedDraftResponse
+type TemplateCreateEmbeddedDraftResponse struct {
+	Template TemplateCreateEmbeddedDraftResponseTemplate `json:"template"`
+	Warnings []WarningResponse                           `json:"warnings,omitempty"` // A list of warnings.
+}
+
+// TemplateCreateEmbeddedDraftResponseTemplate Template object with parameters: `template_id`, `edit_url`, `expires_at`.
+type TemplateCreateEmbeddedDraftResponseTemplate struct {
+	// The id of the Template.
+	TemplateId string `json:"template_id,omitempty"`
+	// Link to edit the template.
+	EditURL string `json:"edit_url,omitempty"`
+	// When the link expires.
+	ExpiresAt *UnixTimestamp `json:"expires_at,omitempty"`
+}
+
+// TemplateAddUserRequest struct for TemplateAddUserRequest
+type TemplateAddUserRequest struct {
+	// The id of the Account to give access to the Template.  **NOTE:** The account id prevails if email address is also provided.
+	AccountId string `json:"account_id,omitempty"`
+	// The email address of the Account to give access to the Template.  **NOTE:** The account id prevails if it is also provided.
+	EmailAddress string `json:"email_address,omitempty"`
+	// If set to `true`, the user does not receive an email notification when a template has been shared with them. Defaults to `false`.
+	SkipNotification bool `json:"skip_notification,omitempty"`
+}
+
+// TemplateRemoveUserRequest struct for TemplateRemoveUserRequest
+type TemplateRemoveUserRequest struct {
+	// The id or email address of the Account to remove access to the Template. The account id prevails if both are provided.
+	AccountId string `json:"account_id,omitempty"`
+	// The id or email address of the Account to remove access to the Template. The account id prevails if both are provided.
+	EmailAddress string `json:"email_address,omitempty"`
+}
+
+// TemplateUpdateFilesRequest struct for TemplateUpdateFilesRequest
+type TemplateUpdateFilesRequest struct {
+	// Client id of the app you're using to update this template.
+	ClientId string `json:"client_id,omitempty"`
+	// Use `files[]` to indicate the uploaded file(s) to use for the template.  This endpoint requires either **files** or
+	// **file_urls[]**, but not both.
+	Files []*File `json:"files,omitempty"`
+	// Use `file_urls[]` to have Dropbox Sign download the file(s) to use for the template.  This endpoint requires either **files** or
+	// **file_urls[]**, but not both.
+	FileUrls []string `json:"file_urls,omitempty"`
+	// The new default template email message.
+	Message string `json:"message,omitempty"`
+	// The new default template email subject.
+	Subject string `json:"subject,omitempty"`
+	// Whether this is a test, the signature request created from this draft will not be legally binding if set to `true`. Defaults to
+	// `false`.
+	TestMode bool `json:"test_mode,omitempty"`
+}
+
+// TemplateUpdateFilesResponse struct for TemplateUpdateFilesResponse
+type TemplateUpdateFilesResponse struct {
+	Template TemplateUpdateFilesResponseTemplate `json:"template"`
+}
+
+// TemplateUpdateFilesResponseTemplate Contains template id
+type TemplateUpdateFilesResponseTemplate struct {
+	// The id of the Template.
+	TemplateId string `json:"template_id,omitempty"`
+	// A list of warnings.
+	Warnings []WarningResponse `json:"warnings,omitempty"`
+}
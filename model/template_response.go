@@ -0,0 +1,251 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// TemplateGetResponse models the response from template API endpoints
+type TemplateGetResponse struct {
+	Template TemplateResponse  `json:"template"`
+	Warnings []WarningResponse `json:"warnings,omitempty"` // A list of warnings.
+}
+
+// TemplateResponse Contains information about the templates you and your team have created.
+type TemplateResponse struct {
+	// The id of the Template.
+	TemplateId string `json:"template_id,omitempty"`
+	// The title of the Template. This will also be the default subject of the message sent to signers when using this Template to send a
+	// SignatureRequest. This can be changed when sending the SignatureRequest.
+	Title string `json:"title,omitempty"`
+	// The default message that will be sent to signers when using this Template to send a SignatureRequest. This can be changed when
+	// sending the SignatureRequest.
+	Message string `json:"message,omitempty"`
+	// Time the template was last updated.
+	UpdatedAt *UnixTimestamp `json:"updated_at,omitempty"`
+	// `true` if this template was created using an embedded flow, `false` if it was created on our website. Will be `null` when you are
+	// not the creator of the Template.
+	IsEmbedded *bool `json:"is_embedded,omitempty"`
+	// `true` if you are the owner of this template, `false` if it's been shared with you by a team member.
+	IsCreator bool `json:"is_creator,omitempty"`
+	// Indicates whether edit rights have been granted to you by the owner (always `true` if that's you).
+	CanEdit bool `json:"can_edit,omitempty"`
+	// Indicates whether the template is locked. If `true`, then the template was created outside your quota and can only be used in
+	// `test_mode`. If `false`, then the template is within your quota and can be used to create signature requests.
+	IsLocked bool `json:"is_locked,omitempty"`
+	// The metadata attached to the template.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// An array of the designated signer roles that must be specified when sending a SignatureRequest using this Template.
+	SignerRoles []TemplateResponseSignerRole `json:"signer_roles,omitempty"`
+	// An array of the designated CC roles that must be specified when sending a SignatureRequest using this Template.
+	CCRoles []TemplateResponseCCRole `json:"cc_roles,omitempty"`
+	// An array describing each document associated with this Template. Includes form field data for each document.
+	Documents []TemplateResponseDocument `json:"documents,omitempty"`
+	// Deprecated. Use `custom_fields` inside the [documents](/api/reference/operation/templateGet/#!c=200&path=template/documents&t=response)
+	// array instead.
+	CustomFields []TemplateResponseDocumentCustomField `json:"custom_fields,omitempty"`
+	// Deprecated. Use `form_fields` inside the [documents](/api/reference/operation/templateGet/#!c=200&path=template/documents&t=response)
+	// array instead.
+	NamedFormFields []TemplateResponseDocumentFormField `json:"named_form_fields,omitempty"`
+	// An array of the Accounts that can use this Template.
+	Accounts []TemplateResponseAccount `json:"accounts,omitempty"`
+	// Signer attachments.
+	Attachments []SignatureRequestResponseAttachment `json:"attachments,omitempty"`
+}
+
+// TemplateResponseSignerRole struct for TemplateResponseSignerRole
+type TemplateResponseSignerRole struct {
+	// The name of the Role.
+	Name string `json:"name,omitempty"`
+	// If signer order is assigned this is the 0-based index for this role.
+	Order *int `json:"order,omitempty"`
+}
+
+// TemplateResponseCCRole struct for TemplateResponseCCRole
+type TemplateResponseCCRole struct {
+	// The name of the Role.
+	Name string `json:"name,omitempty"`
+}
+
+// TemplateResponseDocument struct for TemplateResponseDocument
+type TemplateResponseDocument struct {
+	// Name of the associated file.
+	Name string `json:"name,omitempty"`
+	// Document ordering, the lowest index is displayed first and the highest last (0-based indexing).
+	Index *int `json:"index,omitempty"`
+	// An array of Form Field Group objects.
+	FieldGroups []TemplateResponseDocumentFieldGroup `json:"field_groups,omitempty"`
+	// An array of Form Field objects containing the name and type of each named field.
+	FormFields []TemplateResponseDocumentFormField `json:"form_fields,omitempty"`
+	// An array of Form Field objects containing the name and type of each named field.
+	CustomFields []TemplateResponseDocumentCustomField `json:"custom_fields,omitempty"`
+	// An array describing static overlay fields. **NOTE:** Only available for certain subscriptions.
+	StaticFields []TemplateResponseDocumentStaticField `json:"static_fields,omitempty"`
+}
+
+// TemplateResponseDocumentFieldGroup struct for TemplateResponseDocumentFieldGroup
+type TemplateResponseDocumentFieldGroup struct {
+	// The name of the form field group.
+	Name string                                  `json:"name,omitempty"`
+	Rule *TemplateResponseDocumentFieldGroupRule `json:"rule,omitempty"`
+}
+
+// TemplateResponseDocumentFieldGroupRule The rule used to validate checkboxes in the form field group. See [checkbox field grouping](/api/reference/constants/#checkbox-field-grouping).
+type TemplateResponseDocumentFieldGroupRule struct {
+	// Examples: `require_0-1` `require_1` `require_1-ormore`  - Check out the list of [acceptable `requirement` checkbox type
+	// values](/api/reference/constants/#checkbox-field-grouping). - Check out the list of [acceptable `requirement` radio type
+	// fields](/api/reference/constants/#radio-field-grouping). - Radio groups require **at least** two fields per group.
+	Requirement string `json:"requirement,omitempty"`
+	// Name of the group
+	GroupLabel string `json:"groupLabel,omitempty"`
+}
+
+// TemplateResponseDocumentFormField An array of Form Field objects containing the name and type of each named field.
+type TemplateResponseDocumentFormField struct {
+	// A unique id for the form field.
+	ApiId string `json:"api_id,omitempty"`
+	// The name of the form field.
+	Name string `json:"name,omitempty"`
+	// The type of this form field. See [field types](/api/reference/constants/#field-types).
+	Type string `json:"type"`
+	// The signer of the Form Field.
+	Signer StringOrInt `json:"signer,omitempty"`
+	// The horizontal offset in pixels for this form field.
+	X int `json:"x,omitempty"`
+	// The vertical offset in pixels for this form field.
+	Y int `json:"y,omitempty"`
+	// The width in pixels of this form field.
+	Width int `json:"width,omitempty"`
+	// The height in pixels of this form field.
+	Height int `json:"height,omitempty"`
+	// Boolean showing whether or not this field is required.
+	Required bool `json:"required,omitempty"`
+	// The name of the group this field is in. If this field is not a group, this defaults to `null` except for Radio fields.
+	Group string `json:"group,omitempty"`
+	// Average text length in this field. Only applies to text fields.
+	AvgTextLength *TemplateResponseFieldAvgTextLength `json:"avg_text_length,omitempty"`
+	// Whether this form field is multiline text. Only applies to text fields.
+	IsMultiline bool `json:"isMultiline,omitempty"`
+	// Original font size used in this form field's text. Only applies to text fields.
+	OriginalFontSize int `json:"originalFontSize,omitempty"`
+	// Font family used in this form field's text. Only applies to text fields.
+	FontFamily string `json:"fontFamily,omitempty"`
+}
+
+// TemplateResponseDocumentCustomField An array of Form Field objects containing the name and type of each named field.
+type TemplateResponseDocumentCustomField struct {
+	// The unique ID for this field.
+	ApiId string `json:"api_id,omitempty"`
+	// The name of the Custom Field.
+	Name string `json:"name,omitempty"`
+	// The type of this Custom Field. Only `text` and `checkbox` are currently supported.
+	Type string `json:"type"`
+	// The signer of the Custom Field. Can be `null` if field is a merge field (assigned to Sender).
+	Signer StringOrInt `json:"signer,omitempty"`
+	// The horizontal offset in pixels for this form field.
+	X int `json:"x,omitempty"`
+	// The vertical offset in pixels for this form field.
+	Y int `json:"y,omitempty"`
+	// The width in pixels of this form field.
+	Width int `json:"width,omitempty"`
+	// The height in pixels of this form field.
+	Height int `json:"height,omitempty"`
+	// Boolean showing whether or not this field is required.
+	Required bool `json:"required,omitempty"`
+	// The name of the group this field is in. If this field is not a group, this defaults to `null`.
+	Group string `json:"group,omitempty"`
+	// Average text length in this field. Only applies to text fields.
+	AvgTextLength *TemplateResponseFieldAvgTextLength `json:"avg_text_length,omitempty"`
+	// Whether this form field is multiline text. Only applies to text fields.
+	IsMultiline bool `json:"isMultiline,omitempty"`
+	// Original font size used in this form field's text. Only applies to text fields.
+	OriginalFontSize int `json:"originalFontSize,omitempty"`
+	// Font family used in this form field's text. Only applies to text fields.
+	FontFamily string `json:"fontFamily,omitempty"`
+}
+
+// TemplateResponseDocumentStaticField An array describing static overlay fields. **NOTE:** Only available for certain subscriptions.
+type TemplateResponseDocumentStaticField struct {
+	// A unique id for the static field.
+	ApiId string `json:"api_id,omitempty"`
+	// The name of the static field.
+	Name string `json:"name,omitempty"`
+	// The type of this static field. See [field types](/api/reference/constants/#field-types).
+	Type string `json:"type"`
+	// The signer of the Static Field.
+	Signer StringOrInt `json:"signer,omitempty"`
+	// The horizontal offset in pixels for this static field.
+	X int `json:"x,omitempty"`
+	// The vertical offset in pixels for this static field.
+	Y int `json:"y,omitempty"`
+	// The width in pixels of this static field.
+	Width int `json:"width,omitempty"`
+	// The height in pixels of this static field.
+	Height int `json:"height,omitempty"`
+	// Boolean showing whether or not this field is required.
+	Required bool `json:"required,omitempty"`
+	// The name of the group this field is in. If this field is not a group, this defaults to `null`.
+	Group string `json:"group,omitempty"`
+}
+
+// TemplateResponseFieldAvgTextLength Average text length in this field.
+type TemplateResponseFieldAvgTextLength struct {
+	// Number of lines.
+	NumLines int `json:"num_lines,omitempty"`
+	// Number of characters per line.
+	NumCharsPerLine int `json:"num_chars_per_line,omitempty"`
+}
+
+// TemplateResponseAccount struct for TemplateResponseAccount
+type TemplateResponseAccount struct {
+	// The id of the Account.
+	AccountId string `json:"account_id,omitempty"`
+	// The email address associated with the Account.
+	EmailAddress string `json:"email_address,omitempty"`
+	// Returns `true` if the user has been locked out of their account by a team admin.
+	IsLocked bool `json:"is_locked,omitempty"`
+	// Returns `true` if the user has a paid Dropbox Sign account.
+	IsPaidHs bool `json:"is_paid_hs,omitempty"`
+	// Returns `true` if the user has a paid HelloFax account.
+	IsPaidHf bool `json:"is_paid_hf,omitempty"`
+	// An array of the designated CC roles that must be specified when sending a SignatureRequest using this Template.
+	Quotas *TemplateResponseAccountQuota `json:"quotas,omitempty"`
+}
+
+// TemplateResponseAccountQuota An array of the designated CC roles that must be specified when sending a SignatureRequest using this Template.
+type TemplateResponseAccountQuota struct {
+	// API templates remaining.
+	TemplatesLeft *int `json:"templates_left,omitempty"`
+	// API signature requests remaining.
+	ApiSignatureRequestsLeft *int `json:"api_signature_requests_left,omitempty"`
+	// Signature requests remaining.
+	DocumentsLeft *int `json:"documents_left,omitempty"`
+	// SMS verifications remaining.
+	SmsVerificationsLeft *int `json:"sms_verifications_left,omitempty"`
+}
+
+// StringOrInt models a value the API encodes in JSON either as a string or as a number, such as the `signer` of
+// template fields, which is a signer index (e.g. `1`) or `"sender"`.
+type StringOrInt string
+
+// UnmarshalJSON parses it from JSON, accepting strings, numbers and null.
+func (v *StringOrInt) UnmarshalJSON(src []byte) error {
+	if bytes.Equal(src, []byte("null")) {
+		*v = ""
+		return nil
+	}
+	if len(src) > 0 && src[0] == '"' {
+		var s string
+		if err := json.Unmarshal(src, &s); err != nil {
+			return err
+		}
+		*v = StringOrInt(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(src, &n); err != nil {
+		return err
+	}
+	*v = StringOrInt(n)
+	return nil
+}
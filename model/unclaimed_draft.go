@@ -0,0 +1,303 @@
+package model
+
+// Unclaimed draft types accepted in `type`.
+const (
+	UnclaimedDraftTypeSendDocument     = "send_document"     // The draft is sent as is, without signers, once claimed.
+	UnclaimedDraftTypeRequestSignature = "request_signature" // The draft is sent for signature once claimed.
+)
+
+// UnclaimedDraftCreateRequest struct for UnclaimedDraftCreateRequest
+type UnclaimedDraftCreateRequest struct {
+	// The type of unclaimed draft to create, one of the UnclaimedDraftType constants. Use `send_document` to create a claimable file,
+	// and `request_signature` for a claimable signature request. If the type is `request_signature` then signers name and email_address
+	// are not optional.
+	Type string `json:"type"`
+	// Use `files[]` to indicate the uploaded file(s) to send for signature.  This endpoint requires either **files** or **file_urls[]**,
+	// but not both.
+	Files []*File `json:"files,omitempty"`
+	// Use `file_urls[]` to have Dropbox Sign download the file(s) to send for signature.  This endpoint requires either **files** or
+	// **file_urls[]**, but not both.
+	FileUrls []string `json:"file_urls,omitempty"`
+	// Allows signers to decline to sign a document if `true`. Defaults to `false`.
+	AllowDecline bool `json:"allow_decline,omitempty"`
+	// A list describing the attachments
+	Attachments []SubAttachment `json:"attachments,omitempty"`
+	// The email addresses that should be CCed.
+	CCEmailAddresses []string `json:"cc_email_addresses,omitempty"`
+	// Client id of the app used to create the draft. Used to apply the branding and callback url defined for the app.
+	ClientId string `json:"client_id,omitempty"`
+	// When used together with merge fields, `custom_fields` allows users to add pre-filled data to their signature requests.
+	CustomFields []SubCustomField `json:"custom_fields,omitempty"`
+	// This allows the requester to specify field options for a signature request.
+	FieldOptions *SubFieldOptions `json:"field_options,omitempty"`
+	// Group information for fields defined in `form_fields_per_document`. String-indexed JSON array with `group_label` and `requirement`
+	// keys. `form_fields_per_document` must contain fields referencing a group defined in `form_field_groups`.
+	FormFieldGroups []SubFormFieldGroup `json:"form_field_groups,omitempty"`
+	// Conditional Logic rules for fields defined in `form_fields_per_document`.
+	FormFieldRules []SubFormFieldRule `json:"form_field_rules,omitempty"`
+	// The fields that should appear on the document, expressed as an array of objects.
+	FormFieldsPerDocument []SubFormFieldsPerDocument `json:"form_fields_per_document,omitempty"`
+	// Enables automatic Text Tag removal when set to true.
+	HideTextTags bool `json:"hide_text_tags,omitempty"`
+	// The custom message in the email that will be sent to the signers.
+	Message string `json:"message,omitempty"`
+	// Key-value data that should be attached to the signature request. This metadata is included in all API responses and events involving
+	// the signature request.  Each request can include up to 10 metadata keys (or 50 nested metadata keys), with key names up to 40
+	// characters long and values up to 1000 characters long.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// When only one step remains in the signature request process and this parameter is set to `false` then the progress stepper will
+	// be hidden.  Defaults to `true`.
+	ShowProgressStepper *bool `json:"show_progress_stepper,omitempty"`
+	// Add Signers to your Unclaimed Draft Signature Request.
+	Signers []SubUnclaimedDraftSigner `json:"signers,omitempty"`
+	// This allows the requester to specify the types allowed for creating a signature.
+	SigningOptions *SubSigningOptions `json:"signing_options,omitempty"`
+	// The URL you want signers redirected to after they successfully sign.
+	SigningRedirectUrl string `json:"signing_redirect_url,omitempty"`
+	// The subject in the email that will be sent to the signers.
+	Subject string `json:"subject,omitempty"`
+	// Whether this is a test, the signature request created from this draft will not be legally binding if set to `true`. Defaults to
+	// `false`.
+	TestMode bool `json:"test_mode,omitempty"`
+	// Set `use_text_tags` to `true` to enable [Text Tags](https://app.hellosign.com/api/textTagsWalkthrough#TextTagIntro) parsing in
+	// your document (defaults to disabled, or `false`). Alternatively, if your PDF contains pre-defined fields, enable the detection of
+	// these fields by setting the `use_preexisting_fields` to `true` (defaults to disabled, or `false`).
+	UsePreexistingFields bool `json:"use_preexisting_fields,omitempty"`
+	// See `use_preexisting_fields`.
+	UseTextTags bool `json:"use_text_tags,omitempty"`
+	// When the signature request will expire. Unsigned signatures will be moved to the expired status, and no longer signable.
+	ExpiresAt *UnixTimestamp `json:"expires_at,omitempty"`
+}
+
+// UnclaimedDraftCreateEmbeddedRequest struct for UnclaimedDraftCreateEmbeddedRequest
+type UnclaimedDraftCreateEmbeddedRequest struct {
+	// Client id of the app used to create the draft. Used to apply the branding and callback url defined for the app.
+	ClientId string `json:"client_id"`
+	// The email address of the user that should be designated as the requester of this draft, if the draft type is
+	// `request_signature`.
+	RequesterEmailAddress string `json:"requester_email_address"`
+	// Use `files[]` to indicate the uploaded file(s) to send for signature.  This endpoint requires either **files** or **file_urls[]**,
+	// but not both.
+	Files []*File `json:"files,omitempty"`
+	// Use `file_urls[]` to have Dropbox Sign download the file(s) to send for signature.  This endpoint requires either **files** or
+	// **file_urls[]**, but not both.
+	FileUrls []string `json:"file_urls,omitempty"`
+	// This allows the requester to specify whether the user is allowed to provide email addresses to CC when claiming the draft.
+	AllowCcs bool `json:"allow_ccs,omitempty"`
+	// Allows signers to decline to sign a document if `true`. Defaults to `false`.
+	AllowDecline bool `json:"allow_decline,omitempty"`
+	// Allows signers to reassign their signature requests to other signers if set to `true`. Defaults to `false`.  **NOTE:** Only
+	// available for Premium plan.
+	AllowReassign bool `json:"allow_reassign,omitempty"`
+	// A list describing the attachments
+	Attachments []SubAttachment `json:"attachments,omitempty"`
+	// The email addresses that should be CCed.
+	CCEmailAddresses []string `json:"cc_email_addresses,omitempty"`
+	// When used together with merge fields, `custom_fields` allows users to add pre-filled data to their signature requests.
+	CustomFields []SubCustomField `json:"custom_fields,omitempty"`
+	// This allows the requester to specify editor options when a preparing a document
+	EditorOptions *SubEditorOptions `json:"editor_options,omitempty"`
+	// This allows the requester to specify field options for a signature request.
+	FieldOptions *SubFieldOptions `json:"field_options,omitempty"`
+	// Provide users the ability to review/edit the signers.
+	ForceSignerPage bool `json:"force_signer_page,omitempty"`
+	// Provide users the ability to review/edit the subject and message.
+	ForceSubjectMessage bool `json:"force_subject_message,omitempty"`
+	// Group information for fields defined in `form_fields_per_document`. String-indexed JSON array with `group_label` and `requirement`
+	// keys. `form_fields_per_document` must contain fields referencing a group defined in `form_field_groups`.
+	FormFieldGroups []SubFormFieldGroup `json:"form_field_groups,omitempty"`
+	// Conditional Logic rules for fields defined in `form_fields_per_document`.
+	FormFieldRules []SubFormFieldRule `json:"form_field_rules,omitempty"`
+	// The fields that should appear on the document, expressed as an array of objects.
+	FormFieldsPerDocument []SubFormFieldsPerDocument `json:"form_fields_per_document,omitempty"`
+	// Enables automatic Text Tag removal when set to true.
+	HideTextTags bool `json:"hide_text_tags,omitempty"`
+	// The request from this draft will not automatically send to signers post-claim if set to `true`. Requester must
+	// [release](/api/reference/operation/signatureRequestReleaseHold/) the request from hold when ready to send. Defaults to `false`.
+	HoldRequest bool `json:"hold_request,omitempty"`
+	// The request created from this draft will also be signable in embedded mode if set to `true`. Defaults to `false`.
+	IsForEmbeddedSigning bool `json:"is_for_embedded_signing,omitempty"`
+	// The custom message in the email that will be sent to the signers.
+	Message string `json:"message,omitempty"`
+	// Key-value data that should be attached to the signature request. This metadata is included in all API responses and events involving
+	// the signature request.  Each request can include up to 10 metadata keys (or 50 nested metadata keys), with key names up to 40
+	// characters long and values up to 1000 characters long.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Controls whether [auto fill fields](https://faq.hellosign.com/hc/en-us/articles/360051467511-Auto-Fill-Fields) can automatically
+	// populate a signer's information during signing.
+	PopulateAutoFillFields bool `json:"populate_auto_fill_fields,omitempty"`
+	// The URL you want signers redirected to after they successfully request a signature.
+	RequestingRedirectUrl string `json:"requesting_redirect_url,omitempty"`
+	// This allows the requester to enable the editor/preview experience.  - `show_preview=true`: Allows requesters to enable the
+	// editor/preview experience. - `show_preview=false`: Allows requesters to disable the editor/preview experience.
+	ShowPreview bool `json:"show_preview,omitempty"`
+	// When only one step remains in the signature request process and this parameter is set to `false` then the progress stepper will
+	// be hidden.  Defaults to `true`.
+	ShowProgressStepper *bool `json:"show_progress_stepper,omitempty"`
+	// Add Signers to your Unclaimed Draft Signature Request.
+	Signers []SubUnclaimedDraftSigner `json:"signers,omitempty"`
+	// This allows the requester to specify the types allowed for creating a signature.
+	SigningOptions *SubSigningOptions `json:"signing_options,omitempty"`
+	// The URL you want signers redirected to after they successfully sign.
+	SigningRedirectUrl string `json:"signing_redirect_url,omitempty"`
+	// Disables the "Me (Now)" option for the person preparing the document. Does not work with type `send_document`. Defaults to
+	// `false`.
+	SkipMeNow bool `json:"skip_me_now,omitempty"`
+	// The subject in the email that will be sent to the signers.
+	Subject string `json:"subject,omitempty"`
+	// Whether this is a test, the signature request created from this draft will not be legally binding if set to `true`. Defaults to
+	// `false`.
+	TestMode bool `json:"test_mode,omitempty"`
+	// The type of the draft, one of the UnclaimedDraftType constants. By default this is `request_signature`, but you can set it to
+	// `send_document` if you want to self sign a document and download it.
+	Type string `json:"type,omitempty"`
+	// Set `use_text_tags` to `true` to enable [Text Tags](https://app.hellosign.com/api/textTagsWalkthrough#TextTagIntro) parsing in
+	// your document (defaults to disabled, or `false`). Alternatively, if your PDF contains pre-defined fields, enable the detection of
+	// these fields by setting the `use_preexisting_fields` to `true` (defaults to disabled, or `false`).
+	UsePreexistingFields bool `json:"use_preexisting_fields,omitempty"`
+	// See `use_preexisting_fields`.
+	UseTextTags bool `json:"use_text_tags,omitempty"`
+	// When the signature request will expire. Unsigned signatures will be moved to the expired status, and no longer signable.
+	ExpiresAt *UnixTimestamp `json:"expires_at,omitempty"`
+}
+
+// UnclaimedDraftCreateEmbeddedWithTemplateRequest struct for UnclaimedDraftCreateEmbeddedWithTemplateRequest
+type UnclaimedDraftCreateEmbeddedWithTemplateRequest struct {
+	// Client id of the app used to create the draft. Used to apply the branding and callback url defined for the app.
+	ClientId string `json:"client_id"`
+	// The email address of the user that should be designated as the requester of this draft.
+	RequesterEmailAddress string `json:"requester_email_address"`
+	// Use `template_ids` to create a SignatureRequest from one or more templates, in the order in which the template will be used.
+	TemplateIds []string `json:"template_ids"`
+	// This allows the requester to specify whether the user is allowed to provide email addresses to CC when claiming the draft.
+	AllowCcs bool `json:"allow_ccs,omitempty"`
+	// Allows signers to decline to sign a document if `true`. Defaults to `false`.
+	AllowDecline bool `json:"allow_decline,omitempty"`
+	// Allows signers to reassign their signature requests to other signers if set to `true`. Defaults to `false`.  **NOTE:** Only
+	// available for Premium plan.
+	AllowReassign bool `json:"allow_reassign,omitempty"`
+	// Add CC email recipients. Required when a CC role exists for the Template.
+	CCs []SubCC `json:"ccs,omitempty"`
+	// An array defining values and options for custom fields. Required when a custom field exists in the Template.
+	CustomFields []SubCustomField `json:"custom_fields,omitempty"`
+	// This allows the requester to specify editor options when a preparing a document
+	EditorOptions *SubEditorOptions `json:"editor_options,omitempty"`
+	// This allows the requester to specify field options for a signature request.
+	FieldOptions *SubFieldOptions `json:"field_options,omitempty"`
+	// Use `files[]` to append additional files to the signature request being created from the template. Dropbox Sign will download the
+	// file(s) to append to the signature request being created from the template.  This endpoint requires either **files** or
+	// **file_urls[]**, but not both.
+	Files []*File `json:"files,omitempty"`
+	// Use `file_urls[]` to append additional files to the signature request being created from the template.  This endpoint requires
+	// either **files** or **file_urls[]**, but not both.
+	FileUrls []string `json:"file_urls,omitempty"`
+	// Provide users the ability to review/edit the template signer roles.
+	ForceSignerRoles bool `json:"force_signer_roles,omitempty"`
+	// Provide users the ability to review/edit the template subject and message.
+	ForceSubjectMessage bool `json:"force_subject_message,omitempty"`
+	// The request from this draft will not automatically send to signers post-claim if set to 1. Requester must
+	// [release](/api/reference/operation/signatureRequestReleaseHold/) the request from hold when ready to send. Defaults to `false`.
+	HoldRequest bool `json:"hold_request,omitempty"`
+	// The request created from this draft will also be signable in embedded mode if set to `true`. Defaults to `false`.
+	IsForEmbeddedSigning bool `json:"is_for_embedded_signing,omitempty"`
+	// The custom message in the email that will be sent to the signers.
+	Message string `json:"message,omitempty"`
+	// Key-value data that should be attached to the signature request. This metadata is included in all API responses and events involving
+	// the signature request.  Each request can include up to 10 metadata keys (or 50 nested metadata keys), with key names up to 40
+	// characters long and values up to 1000 characters long.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Controls whether [auto fill fields](https://faq.hellosign.com/hc/en-us/articles/360051467511-Auto-Fill-Fields) can automatically
+	// populate a signer's information during signing.
+	PopulateAutoFillFields bool `json:"populate_auto_fill_fields,omitempty"`
+	// This allows the requester to enable the preview experience (i.e. does not allow the requester's end user to add any additional
+	// fields via the editor).  **NOTE:** This parameter overwrites `show_preview=1` (if set).
+	PreviewOnly bool `json:"preview_only,omitempty"`
+	// The URL you want signers redirected to after they successfully request a signature.
+	RequestingRedirectUrl string `json:"requesting_redirect_url,omitempty"`
+	// This allows the requester to enable the editor/preview experience.
+	ShowPreview bool `json:"show_preview,omitempty"`
+	// When only one step remains in the signature request process and this parameter is set to `false` then the progress stepper will
+	// be hidden.  Defaults to `true`.
+	ShowProgressStepper *bool `json:"show_progress_stepper,omitempty"`
+	// Add Signers to your Templated-based Signature Request.
+	Signers []SubUnclaimedDraftTemplateSigner `json:"signers,omitempty"`
+	// This allows the requester to specify the types allowed for creating a signature.
+	SigningOptions *SubSigningOptions `json:"signing_options,omitempty"`
+	// The URL you want signers redirected to after they successfully sign.
+	SigningRedirectUrl string `json:"signing_redirect_url,omitempty"`
+	// Disables the "Me (Now)" option for the person preparing the document. Defaults to `false`.
+	SkipMeNow bool `json:"skip_me_now,omitempty"`
+	// The subject in the email that will be sent to the signers.
+	Subject string `json:"subject,omitempty"`
+	// Whether this is a test, the signature request created from this draft will not be legally binding if set to `true`. Defaults to
+	// `false`.
+	TestMode bool `json:"test_mode,omitempty"`
+	// The title you want to assign to the SignatureRequest.
+	Title string `json:"title,omitempty"`
+}
+
+// UnclaimedDraftEditAndResendRequest struct for UnclaimedDraftEditAndResendRequest
+type UnclaimedDraftEditAndResendRequest struct {
+	// Client id of the app used to create the draft. Used to apply the branding and callback url defined for the app.
+	ClientId string `json:"client_id"`
+	// This allows the requester to specify editor options when a preparing a document
+	EditorOptions *SubEditorOptions `json:"editor_options,omitempty"`
+	// The request created from this draft will also be signable in embedded mode if set to `true`.
+	IsForEmbeddedSigning bool `json:"is_for_embedded_signing,omitempty"`
+	// The email address of the user that should be designated as the requester of this draft. If not set, original requester's email
+	// address will be used.
+	RequesterEmailAddress string `json:"requester_email_address,omitempty"`
+	// The URL you want signers redirected to after they successfully request a signature.
+	RequestingRedirectUrl string `json:"requesting_redirect_url,omitempty"`
+	// When only one step remains in the signature request process and this parameter is set to `false` then the progress stepper will
+	// be hidden.  Defaults to `true`.
+	ShowProgressStepper *bool `json:"show_progress_stepper,omitempty"`
+	// The URL you want signers redirected to after they successfully sign.
+	SigningRedirectUrl string `json:"signing_redirect_url,omitempty"`
+	// Whether this is a test, the signature request created from this draft will not be legally binding if set to `true`. Defaults to
+	// `false`.
+	TestMode bool `json:"test_mode,omitempty"`
+}
+
+// SubUnclaimedDraftSigner struct for SubUnclaimedDraftSigner
+type SubUnclaimedDraftSigner struct {
+	// The email address of the signer.
+	EmailAddress string `json:"email_address"`
+	// The name of the signer.
+	Name string `json:"name"`
+	// The order the signer is required to sign in.
+	Order *int `json:"order,omitempty"`
+}
+
+// SubUnclaimedDraftTemplateSigner struct for SubUnclaimedDraftTemplateSigner
+type SubUnclaimedDraftTemplateSigner struct {
+	// Must match an existing role in chosen Template(s).
+	Role string `json:"role"`
+	// The name of the signer filling the role of `role`.
+	Name string `json:"name"`
+	// The email address of the signer filling the role of `role`.
+	EmailAddress string `json:"email_address"`
+}
+
+// UnclaimedDraftCreateResponse struct for UnclaimedDraftCreateResponse
+type UnclaimedDraftCreateResponse struct {
+	UnclaimedDraft UnclaimedDraftResponse `json:"unclaimed_draft"`
+	Warnings       []WarningResponse      `json:"warnings,omitempty"` // A list of warnings.
+}
+
+// UnclaimedDraftResponse A group of documents that a user can take ownership of via the claim URL.
+type UnclaimedDraftResponse struct {
+	// The ID of the signature request that is represented by this UnclaimedDraft.
+	SignatureRequestId string `json:"signature_request_id,omitempty"`
+	// The URL to be used to claim this UnclaimedDraft.
+	ClaimURL string `json:"claim_url,omitempty"`
+	// The URL you want signers redirected to after they successfully sign.
+	SigningRedirectUrl string `json:"signing_redirect_url,omitempty"`
+	// The URL you want signers redirected to after they successfully request a signature (will only be returned in the response if it is
+	// applicable to the request).
+	RequestingRedirectUrl string `json:"requesting_redirect_url,omitempty"`
+	// When the link expires.
+	ExpiresAt *UnixTimestamp `json:"expires_at,omitempty"`
+	// Whether this is a test draft. Signature requests made from test drafts have no legal value.
+	TestMode bool `json:"test_mode,omitempty"`
+}
@@ -0,0 +1,690 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxMetadataKeys        = 10
+	maxNestedMetadataKeys  = 50
+	maxMetadataKeyLength   = 40
+	maxMetadataValueLength = 1000
+	minPinLength           = 4
+	maxPinLength           = 12
+	maxPageSize            = 100
+)
+
+// e164Pattern matches phone numbers in the E.164 format, e.g. "+14155550100"
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// FieldError is a problem with the value of a request field.
+type FieldError struct {
+	Field   string // Path of the field, using the JSON names of the API, e.g. "signers[0].pin".
+	Message string // What is wrong with the field.
+}
+
+// Error implements the error interface
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationError is returned by the Validate methods of requests, listing all the problems found.
+//
+// Validate checks a request against the constraints documented by the API, such as required fields, mutually
+// exclusive ones, formats and limits, so that mistakes are reported before anything is sent.  It does not stop at the
+// first problem.  Constraints which depend on the account or on templates are left to the API.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		msgs[i] = fe.Error()
+	}
+	return "invalid request: " + strings.Join(msgs, "; ")
+}
+
+// validator accumulates the problems found while validating a request
+type validator struct {
+	errs []FieldError
+}
+
+// addf records a problem with field
+func (v *validator) addf(field, format string, args ...any) {
+	v.errs = append(v.errs, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// err returns the problems found as a *ValidationError, or nil if there are none
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: v.errs}
+}
+
+// required checks that a string field is set
+func (v *validator) required(field, value string) {
+	if value == "" {
+		v.addf(field, "is required")
+	}
+}
+
+// oneOf checks that a string field, if set, has one of the allowed values
+func (v *validator) oneOf(field, value string, allowed ...string) {
+	if value == "" {
+		return
+	}
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.addf(field, "must be one of %s, got %q", strings.Join(allowed, ", "), value)
+}
+
+// files checks that files and fileUrls are not both set, and that one of them is if required is true
+func (v *validator) files(files []*File, fileUrls []string, required bool) {
+	switch {
+	case len(files) > 0 && len(fileUrls) > 0:
+		v.addf("files", "cannot be used together with file_urls")
+	case required && len(files) == 0 && len(fileUrls) == 0:
+		v.addf("files", "either files or file_urls is required")
+	}
+	for i, f := range files {
+		if f == nil {
+			v.addf(fmt.Sprintf("files[%d]", i), "is nil")
+		}
+	}
+}
+
+// signer checks a signer of a signature request
+func (v *validator) signer(field string, s SubSignatureRequestSigner) {
+	v.required(field+".name", s.Name)
+	v.required(field+".email_address", s.EmailAddress)
+	v.pin(field+".pin", s.Pin)
+	v.sms(field, s.SmsPhoneNumber, s.SmsPhoneNumberType)
+}
+
+// templateSigner checks a signer of a signature request using templates
+func (v *validator) templateSigner(field string, s SubSignatureRequestTemplateSigner) {
+	v.required(field+".role", s.Role)
+	v.required(field+".name", s.Name)
+	v.required(field+".email_address", s.EmailAddress)
+	v.pin(field+".pin", s.Pin)
+	v.sms(field, s.SmsPhoneNumber, s.SmsPhoneNumberType)
+}
+
+// signers checks that exactly one of signers and groupedSigners is set, and their contents
+func (v *validator) signers(signers []SubSignatureRequestSigner, groupedSigners []SubSignatureRequestGroupedSigners) {
+	switch {
+	case len(signers) > 0 && len(groupedSigners) > 0:
+		v.addf("signers", "cannot be used together with grouped_signers")
+	case len(signers) == 0 && len(groupedSigners) == 0:
+		v.addf("signers", "either signers or grouped_signers is required")
+	}
+	for i, s := range signers {
+		v.signer(fmt.Sprintf("signers[%d]", i), s)
+	}
+	for i, g := range groupedSigners {
+		field := fmt.Sprintf("grouped_signers[%d]", i)
+		v.required(field+".group", g.Group)
+		if len(g.Signers) == 0 {
+			v.addf(field+".signers", "is required")
+		}
+		for j, s := range g.Signers {
+			v.signer(fmt.Sprintf("%s.signers[%d]", field, j), s)
+		}
+	}
+}
+
+// pin checks the length of a signer's access code
+func (v *validator) pin(field, pin string) {
+	if n := utf8.RuneCountInString(pin); pin != "" && (n < minPinLength || n > maxPinLength) {
+		v.addf(field, "must be %d to %d characters long, got %d", minPinLength, maxPinLength, n)
+	}
+}
+
+// sms checks the SMS phone number of a signer and its type
+func (v *validator) sms(field, number, numberType string) {
+	if number != "" && !e164Pattern.MatchString(number) {
+		v.addf(field+".sms_phone_number", "must be an E.164 formatted phone number, e.g. +14155550100")
+	}
+	v.oneOf(field+".sms_phone_number_type", numberType, "authentication", "delivery")
+	if numberType != "" && number == "" {
+		v.addf(field+".sms_phone_number_type", "requires sms_phone_number")
+	}
+}
+
+// ccs checks the CC recipients of a signature request using templates
+func (v *validator) ccs(ccs []SubCC) {
+	for i, cc := range ccs {
+		field := fmt.Sprintf("ccs[%d]", i)
+		v.required(field+".role", cc.Role)
+		v.required(field+".email_address", cc.EmailAddress)
+	}
+}
+
+// customFields checks the custom fields of a signature request
+func (v *validator) customFields(fields []SubCustomField) {
+	for i, f := range fields {
+		field := fmt.Sprintf("custom_fields[%d]", i)
+		v.required(field+".name", f.Name)
+		if f.Required && f.Editor == "" {
+			v.addf(field+".editor", "is required when required is true")
+		}
+	}
+}
+
+// attachments checks the attachments of a signature request
+func (v *validator) attachments(attachments []SubAttachment, numSigners int) {
+	for i, a := range attachments {
+		field := fmt.Sprintf("attachments[%d]", i)
+		v.required(field+".name", a.Name)
+		if a.SignerIndex < 0 || (numSigners > 0 && a.SignerIndex >= numSigners) {
+			v.addf(field+".signer_index", "must be the index of a signer, got %d", a.SignerIndex)
+		}
+	}
+}
+
+// metadata checks the number and size of metadata keys and values
+func (v *validator) metadata(metadata map[string]interface{}) {
+	if len(metadata) > maxMetadataKeys {
+		v.addf("metadata", "can have at most %d keys, got %d", maxMetadataKeys, len(metadata))
+	}
+	if n := v.metadataEntries("metadata", metadata); n > maxNestedMetadataKeys {
+		v.addf("metadata", "can have at most %d nested keys, got %d", maxNestedMetadataKeys, n)
+	}
+}
+
+// metadataEntries checks the keys and values of a metadata object, returning the number of keys it holds including
+// nested ones
+func (v *validator) metadataEntries(field string, metadata map[string]interface{}) int {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	count := 0
+	for _, key := range keys {
+		value := metadata[key]
+		count++
+		path := field + "." + key
+		if n := utf8.RuneCountInString(key); n > maxMetadataKeyLength {
+			v.addf(path, "key must be at most %d characters long, got %d", maxMetadataKeyLength, n)
+		}
+		switch value := value.(type) {
+		case map[string]interface{}:
+			count += v.metadataEntries(path, value)
+		case nil:
+		default:
+			if n := utf8.RuneCountInString(fmt.Sprint(value)); n > maxMetadataValueLength {
+				v.addf(path, "value must be at most %d characters long, got %d", maxMetadataValueLength, n)
+			}
+		}
+	}
+	return count
+}
+
+// signingOptions checks that the default signature type is one of the enabled ones.  When none of the types is set,
+// those enabled in the account settings apply, which cannot be checked.
+func (v *validator) signingOptions(o *SubSigningOptions) {
+	if o == nil {
+		return
+	}
+	types := map[string]*bool{"draw": o.Draw, "phone": o.Phone, "type": o.Type, "upload": o.Upload}
+	anySet := o.Draw != nil || o.Phone != nil || o.Type != nil || o.Upload != nil
+	enabled, known := types[o.DefaultType]
+	switch {
+	case o.DefaultType == "":
+		v.addf("signing_options.default_type", "is required")
+	case !known:
+		v.oneOf("signing_options.default_type", o.DefaultType, "draw", "phone", "type", "upload")
+	case anySet && (enabled == nil || !*enabled):
+		v.addf("signing_options.default_type", "%q is not enabled in signing_options", o.DefaultType)
+	}
+}
+
+// formFields checks the fields placed on the documents, including the parameters specific to each field type
+func (v *validator) formFields(fields []SubFormFieldsPerDocument) {
+	apiIds := make(map[string]int)
+	for i, f := range fields {
+		field := fmt.Sprintf("form_fields_per_document[%d]", i)
+		v.required(field+".api_id", f.ApiId)
+		if first, dup := apiIds[f.ApiId]; dup && f.ApiId != "" {
+			v.addf(field+".api_id", "%q is already used by form_fields_per_document[%d]", f.ApiId, first)
+		} else {
+			apiIds[f.ApiId] = i
+		}
+		v.required(field+".signer", f.Signer)
+		v.required(field+".type", f.Type)
+		v.oneOf(field+".type", f.Type, FormFieldTypeText, FormFieldTypeCheckbox, FormFieldTypeRadio, FormFieldTypeSignature,
+			FormFieldTypeInitials, FormFieldTypeDateSigned, FormFieldTypeDropdown, FormFieldTypeHyperlink, FormFieldTypeTextMerge,
+			FormFieldTypeCheckboxMerge)
+		if f.DocumentIndex < 0 {
+			v.addf(field+".document_index", "must not be negative")
+		}
+
+		switch f.Type {
+		case FormFieldTypeCheckbox:
+			if f.IsChecked == nil {
+				v.addf(field+".is_checked", "is required for checkbox fields")
+			}
+		case FormFieldTypeRadio:
+			if f.IsChecked == nil {
+				v.addf(field+".is_checked", "is required for radio fields")
+			}
+			v.required(field+".group", f.Group)
+		case FormFieldTypeDropdown:
+			if len(f.Options) == 0 {
+				v.addf(field+".options", "is required for dropdown fields")
+			}
+		case FormFieldTypeHyperlink:
+			v.required(field+".content", f.Content)
+			v.required(field+".content_url", f.ContentUrl)
+		}
+		if f.ValidationType == "custom_regex" {
+			v.required(field+".validation_custom_regex", f.ValidationCustomRegex)
+		}
+	}
+}
+
+// formFieldRules checks the conditional logic rules of the form fields
+func (v *validator) formFieldRules(rules []SubFormFieldRule) {
+	for i, r := range rules {
+		field := fmt.Sprintf("form_field_rules[%d]", i)
+		v.required(field+".id", r.Id)
+		v.oneOf(field+".trigger_operator", r.TriggerOperator, "AND")
+		if len(r.Triggers) != 1 {
+			v.addf(field+".triggers", "must have exactly one trigger, got %d", len(r.Triggers))
+		}
+		for j, t := range r.Triggers {
+			tfield := fmt.Sprintf("%s.triggers[%d]", field, j)
+			v.required(tfield+".id", t.Id)
+			v.oneOf(tfield+".operator", t.Operator, "any", "is", "match", "none", "not")
+			if (t.Value == "") == (len(t.Values) == 0) {
+				v.addf(tfield, "exactly one of value and values is required")
+			}
+		}
+		if len(r.Actions) == 0 {
+			v.addf(field+".actions", "is required")
+		}
+		for j, a := range r.Actions {
+			afield := fmt.Sprintf("%s.actions[%d]", field, j)
+			v.oneOf(afield+".type", a.Type, "change-field-visibility", "change-group-visibility")
+			if (a.FieldId == "") == (a.GroupId == "") {
+				v.addf(afield, "exactly one of field_id and group_id is required")
+			}
+		}
+	}
+}
+
+// formFieldGroups checks the groups of the form fields
+func (v *validator) formFieldGroups(groups []SubFormFieldGroup) {
+	for i, g := range groups {
+		field := fmt.Sprintf("form_field_groups[%d]", i)
+		v.required(field+".group_id", g.GroupId)
+		v.required(field+".group_label", g.GroupLabel)
+		v.required(field+".requirement", g.Requirement)
+	}
+}
+
+// templateRoles checks the signer roles of a template
+func (v *validator) templateRoles(roles []SubTemplateRole) {
+	names := make(map[string]bool)
+	for i, r := range roles {
+		field := fmt.Sprintf("signer_roles[%d].name", i)
+		v.required(field, r.Name)
+		if names[r.Name] {
+			v.addf(field, "%q is used by several signer roles", r.Name)
+		}
+		names[r.Name] = true
+	}
+}
+
+// mergeFields checks the merge fields of a template
+func (v *validator) mergeFields(fields []SubMergeField) {
+	for i, f := range fields {
+		field := fmt.Sprintf("merge_fields[%d]", i)
+		v.required(field+".name", f.Name)
+		v.required(field+".type", f.Type)
+		v.oneOf(field+".type", f.Type, MergeFieldTypeText, MergeFieldTypeCheckbox)
+	}
+}
+
+// pageSize checks the page size of a list request
+func (v *validator) pageSize(page, pageSize int) {
+	if page < 0 {
+		v.addf("page", "must not be negative")
+	}
+	if pageSize < 0 || pageSize > maxPageSize {
+		v.addf("page_size", "must be between 1 and %d, got %d", maxPageSize, pageSize)
+	}
+}
+
+// Validate requires the client id, templates and signers, and checks the signers, CCs, custom fields, files,
+// metadata and signing options.
+func (r CreateEmbeddedWithTemplateRequest) Validate() error {
+	var v validator
+	v.required("client_id", r.ClientId)
+	if len(r.TemplateIds) == 0 {
+		v.addf("template_ids", "is required")
+	}
+	if len(r.Signers) == 0 {
+		v.addf("signers", "is required")
+	}
+	for i, s := range r.Signers {
+		v.templateSigner(fmt.Sprintf("signers[%d]", i), s)
+	}
+	v.ccs(r.CCs)
+	v.customFields(r.CustomFields)
+	v.files(r.Files, r.FileUrls, false)
+	v.metadata(r.Metadata)
+	v.signingOptions(r.SigningOptions)
+	return v.err()
+}
+
+// Validate requires the templates and signers, and checks the signers, CCs, custom fields, files, metadata and
+// signing options.
+func (r SendWithTemplateRequest) Validate() error {
+	var v validator
+	if len(r.TemplateIds) == 0 {
+		v.addf("template_ids", "is required")
+	}
+	if len(r.Signers) == 0 {
+		v.addf("signers", "is required")
+	}
+	for i, s := range r.Signers {
+		v.templateSigner(fmt.Sprintf("signers[%d]", i), s)
+	}
+	v.ccs(r.CCs)
+	v.customFields(r.CustomFields)
+	v.files(r.Files, r.FileUrls, false)
+	v.metadata(r.Metadata)
+	v.signingOptions(r.SigningOptions)
+	return v.err()
+}
+
+// Validate requires the client id, files and signers, and checks the attachments, custom fields, form fields,
+// metadata and signing options.
+func (r CreateEmbeddedRequest) Validate() error {
+	var v validator
+	v.required("client_id", r.ClientId)
+	v.files(r.Files, r.FileUrls, true)
+	v.signers(r.Signers, r.GroupedSigners)
+	v.attachments(r.Attachments, len(r.Signers))
+	v.customFields(r.CustomFields)
+	v.formFields(r.FormFieldsPerDocument)
+	v.formFieldGroups(r.FormFieldGroups)
+	v.formFieldRules(r.FormFieldRules)
+	v.metadata(r.Metadata)
+	v.signingOptions(r.SigningOptions)
+	return v.err()
+}
+
+// Validate requires files and signers, and checks the attachments, custom fields, form fields, metadata and signing
+// options.
+func (r SendSignatureRequestRequest) Validate() error {
+	var v validator
+	v.files(r.Files, r.FileUrls, true)
+	v.signers(r.Signers, r.GroupedSigners)
+	v.attachments(r.Attachments, len(r.Signers))
+	v.customFields(r.CustomFields)
+	v.formFields(r.FormFieldsPerDocument)
+	v.formFieldGroups(r.FormFieldGroups)
+	v.formFieldRules(r.FormFieldRules)
+	v.metadata(r.Metadata)
+	v.signingOptions(r.SigningOptions)
+	return v.err()
+}
+
+// Validate requires the email address of the signer to remind.
+func (r RemindSignatureRequestRequest) Validate() error {
+	var v validator
+	v.required("email_address", r.EmailAddress)
+	return v.err()
+}
+
+// Validate requires the signature id and at least one property to update.
+func (r UpdateSignatureRequestRequest) Validate() error {
+	var v validator
+	v.required("signature_id", r.SignatureId)
+	if r.EmailAddress == "" && r.Name == "" && r.ExpiresAt == nil {
+		v.addf("email_address", "one of email_address, name and expires_at is required")
+	}
+	return v.err()
+}
+
+// Validate checks the page and page size.
+func (r ListSignatureRequestsRequest) Validate() error {
+	var v validator
+	v.pageSize(r.Page, r.PageSize)
+	return v.err()
+}
+
+// Validate checks the page and page size.
+func (r ListTemplatesRequest) Validate() error {
+	var v validator
+	v.pageSize(r.Page, r.PageSize)
+	return v.err()
+}
+
+// Validate checks the merge fields, if set.
+func (r EmbeddedEditUrlRequest) Validate() error {
+	var v validator
+	if r.MergeFields != nil {
+		v.mergeFields(*r.MergeFields)
+	}
+	return v.err()
+}
+
+// Validate requires files, signer roles and form fields, and checks the attachments, form fields, merge fields and
+// metadata.
+func (r TemplateCreateRequest) Validate() error {
+	var v validator
+	v.files(r.Files, r.FileUrls, true)
+	if len(r.SignerRoles) == 0 {
+		v.addf("signer_roles", "is required")
+	}
+	v.templateRoles(r.SignerRoles)
+	if len(r.FormFieldsPerDocument) == 0 {
+		v.addf("form_fields_per_document", "is required")
+	}
+	v.attachments(r.Attachments, len(r.SignerRoles))
+	v.formFields(r.FormFieldsPerDocument)
+	v.formFieldGroups(r.FormFieldGroups)
+	v.formFieldRules(r.FormFieldRules)
+	v.mergeFields(r.MergeFields)
+	v.metadata(r.Metadata)
+	return v.err()
+}
+
+// Validate requires the client id and files, and checks the signer roles, attachments, form fields, merge fields
+// and metadata.
+func (r TemplateCreateEmbeddedDraftRequest) Validate() error {
+	var v validator
+	v.required("client_id", r.ClientId)
+	v.files(r.Files, r.FileUrls, true)
+	v.templateRoles(r.SignerRoles)
+	v.attachments(r.Attachments, len(r.SignerRoles))
+	v.formFields(r.FormFieldsPerDocument)
+	v.formFieldGroups(r.FormFieldGroups)
+	v.formFieldRules(r.FormFieldRules)
+	v.mergeFields(r.MergeFields)
+	v.metadata(r.Metadata)
+	return v.err()
+}
+
+// Validate requires the account id or the email address of the user.
+func (r TemplateAddUserRequest) Validate() error {
+	var v validator
+	if r.AccountId == "" && r.EmailAddress == "" {
+		v.addf("account_id", "one of account_id and email_address is required")
+	}
+	return v.err()
+}
+
+// Validate requires the account id or the email address of the user.
+func (r TemplateRemoveUserRequest) Validate() error {
+	var v validator
+	if r.AccountId == "" && r.EmailAddress == "" {
+		v.addf("account_id", "one of account_id and email_address is required")
+	}
+	return v.err()
+}
+
+// Validate requires either files or file URLs.
+func (r TemplateUpdateFilesRequest) Validate() error {
+	var v validator
+	v.files(r.Files, r.FileUrls, true)
+	return v.err()
+}
+
+// bulkSigners checks that exactly one of signerFile and signerList is set, and the contents of signerList
+func (v *validator) bulkSigners(signerFile *File, signerList []SubBulkSignerList) {
+	switch {
+	case signerFile != nil && len(signerList) > 0:
+		v.addf("signer_file", "cannot be used together with signer_list")
+	case signerFile == nil && len(signerList) == 0:
+		v.addf("signer_list", "either signer_list or signer_file is required")
+	}
+	for i, entry := range signerList {
+		field := fmt.Sprintf("signer_list[%d]", i)
+		if len(entry.Signers) == 0 {
+			v.addf(field+".signers", "is required")
+		}
+		for j, s := range entry.Signers {
+			v.templateSigner(fmt.Sprintf("%s.signers[%d]", field, j), s)
+		}
+		for j, f := range entry.CustomFields {
+			v.required(fmt.Sprintf("%s.custom_fields[%d].name", field, j), f.Name)
+		}
+	}
+}
+
+// Validate requires the templates and either a signer file or a signer list, and checks the signers, CCs,
+// custom fields and metadata.
+func (r SignatureRequestBulkSendWithTemplateRequest) Validate() error {
+	var v validator
+	if len(r.TemplateIds) == 0 {
+		v.addf("template_ids", "is required")
+	}
+	v.bulkSigners(r.SignerFile, r.SignerList)
+	v.ccs(r.CCs)
+	v.customFields(r.CustomFields)
+	v.metadata(r.Metadata)
+	return v.err()
+}
+
+// Validate is like that of SignatureRequestBulkSendWithTemplateRequest, also requiring the client id.
+func (r SignatureRequestBulkCreateEmbeddedWithTemplateRequest) Validate() error {
+	var v validator
+	if len(r.TemplateIds) == 0 {
+		v.addf("template_ids", "is required")
+	}
+	v.required("client_id", r.ClientId)
+	v.bulkSigners(r.SignerFile, r.SignerList)
+	v.ccs(r.CCs)
+	v.customFields(r.CustomFields)
+	v.metadata(r.Metadata)
+	return v.err()
+}
+
+// Validate checks the page and page size.
+func (r GetBulkSendJobRequest) Validate() error {
+	var v validator
+	v.pageSize(r.Page, r.PageSize)
+	return v.err()
+}
+
+// Validate checks the page and page size.
+func (r ListBulkSendJobsRequest) Validate() error {
+	var v validator
+	v.pageSize(r.Page, r.PageSize)
+	return v.err()
+}
+
+// unclaimedDraftSigners checks the signers of an unclaimed draft
+func (v *validator) unclaimedDraftSigners(signers []SubUnclaimedDraftSigner) {
+	for i, s := range signers {
+		field := fmt.Sprintf("signers[%d]", i)
+		v.required(field+".name", s.Name)
+		v.required(field+".email_address", s.EmailAddress)
+	}
+}
+
+// Validate requires the draft type and files, and checks the signers, attachments, custom fields, form fields,
+// metadata and signing options.
+func (r UnclaimedDraftCreateRequest) Validate() error {
+	var v validator
+	v.required("type", r.Type)
+	v.oneOf("type", r.Type, UnclaimedDraftTypeSendDocument, UnclaimedDraftTypeRequestSignature)
+	v.files(r.Files, r.FileUrls, true)
+	v.unclaimedDraftSigners(r.Signers)
+	v.attachments(r.Attachments, len(r.Signers))
+	v.customFields(r.CustomFields)
+	v.formFields(r.FormFieldsPerDocument)
+	v.formFieldGroups(r.FormFieldGroups)
+	v.formFieldRules(r.FormFieldRules)
+	v.metadata(r.Metadata)
+	v.signingOptions(r.SigningOptions)
+	return v.err()
+}
+
+// Validate requires the client id, requester email address and files, checks that skip_me_now is only used to request
+// signatures, and checks the signers, attachments, custom fields, form fields, metadata and signing options.
+func (r UnclaimedDraftCreateEmbeddedRequest) Validate() error {
+	var v validator
+	v.required("client_id", r.ClientId)
+	v.required("requester_email_address", r.RequesterEmailAddress)
+	v.oneOf("type", r.Type, UnclaimedDraftTypeSendDocument, UnclaimedDraftTypeRequestSignature)
+	if r.SkipMeNow && r.Type == UnclaimedDraftTypeSendDocument {
+		v.addf("skip_me_now", "cannot be used with type %s", UnclaimedDraftTypeSendDocument)
+	}
+	v.files(r.Files, r.FileUrls, true)
+	v.unclaimedDraftSigners(r.Signers)
+	v.attachments(r.Attachments, len(r.Signers))
+	v.customFields(r.CustomFields)
+	v.formFields(r.FormFieldsPerDocument)
+	v.formFieldGroups(r.FormFieldGroups)
+	v.formFieldRules(r.FormFieldRules)
+	v.metadata(r.Metadata)
+	v.signingOptions(r.SigningOptions)
+	return v.err()
+}
+
+// Validate requires the client id, requester email address and templates, and checks the signers, CCs, custom
+// fields, files, metadata and signing options.
+func (r UnclaimedDraftCreateEmbeddedWithTemplateRequest) Validate() error {
+	var v validator
+	v.required("client_id", r.ClientId)
+	v.required("requester_email_address", r.RequesterEmailAddress)
+	if len(r.TemplateIds) == 0 {
+		v.addf("template_ids", "is required")
+	}
+	for i, s := range r.Signers {
+		field := fmt.Sprintf("signers[%d]", i)
+		v.required(field+".role", s.Role)
+		v.required(field+".name", s.Name)
+		v.required(field+".email_address", s.EmailAddress)
+	}
+	v.ccs(r.CCs)
+	v.customFields(r.CustomFields)
+	v.files(r.Files, r.FileUrls, false)
+	v.metadata(r.Metadata)
+	v.signingOptions(r.SigningOptions)
+	return v.err()
+}
+
+// Validate requires the client id.
+func (r UnclaimedDraftEditAndResendRequest) Validate() error {
+	var v validator
+	v.required("client_id", r.ClientId)
+	return v.err()
+}
@@ -0,0 +1,153 @@
+package model_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fieldErrors returns the paths of the fields reported by err
+func fieldErrors(t *testing.T, err error) []string {
+	t.Helper()
+	var validationErr *model.ValidationError
+	require.True(t, errors.As(err, &validationErr), "expected a *ValidationError, got %v", err)
+	var fields []string
+	for _, fe := range validationErr.Errors {
+		fields = append(fields, fe.Field)
+	}
+	return fields
+}
+
+func TestCreateEmbeddedWithTemplateRequestValidate(t *testing.T) {
+	valid := model.CreateEmbeddedWithTemplateRequest{
+		ClientId:    "ddddb5e5c34b929957e24b17aa52dddd",
+		TemplateIds: []string{"cccc6ad681229567aab20cd83a69cf18fb2cccc"},
+		Signers: []model.SubSignatureRequestTemplateSigner{
+			{Role: "First", Name: "Signer One", EmailAddress: "signer.one@example.org", Pin: "1234", SmsPhoneNumber: "+14155550100", SmsPhoneNumberType: "delivery"},
+		},
+		Metadata:       map[string]interface{}{"order": map[string]interface{}{"id": 42}},
+		SigningOptions: &model.SubSigningOptions{DefaultType: "draw"},
+	}
+	assert.NoError(t, valid.Validate())
+
+	draw, upload := false, true
+	invalid := valid
+	invalid.ClientId = ""
+	invalid.Signers = []model.SubSignatureRequestTemplateSigner{
+		{Role: "First", Name: "Signer One", EmailAddress: "signer.one@example.org", Pin: "123", SmsPhoneNumber: "4155550100", SmsPhoneNumberType: "voice"},
+		{Role: "Second", Name: "Signer Two"},
+	}
+	invalid.Files = []*model.File{model.FileFromBytes("contract.pdf", nil)}
+	invalid.FileUrls = []string{"https://example.org/contract.pdf"}
+	invalid.Metadata = map[string]interface{}{
+		strings.Repeat("k", 41): "value",
+		"long":                  strings.Repeat("v", 1001),
+	}
+	invalid.SigningOptions = &model.SubSigningOptions{DefaultType: "draw", Draw: &draw, Upload: &upload}
+
+	err := invalid.Validate()
+	assert.Equal(t, []string{
+		"client_id",
+		"signers[0].pin",
+		"signers[0].sms_phone_number",
+		"signers[0].sms_phone_number_type",
+		"signers[1].email_address",
+		"files",
+		"metadata.kkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkk",
+		"metadata.long",
+		"signing_options.default_type",
+	}, fieldErrors(t, err))
+	assert.Contains(t, err.Error(), "signers[0].pin: must be 4 to 12 characters long, got 3")
+}
+
+func TestMetadataLimits(t *testing.T) {
+	metadata := map[string]interface{}{}
+	for _, key := range strings.Split("a b c d e f g h i j k", " ") {
+		metadata[key] = key
+	}
+	req := model.SendWithTemplateRequest{
+		TemplateIds: []string{"cccc6ad681229567aab20cd83a69cf18fb2cccc"},
+		Signers:     []model.SubSignatureRequestTemplateSigner{{Role: "First", Name: "Signer One", EmailAddress: "signer.one@example.org"}},
+		Metadata:    metadata,
+	}
+	assert.Equal(t, []string{"metadata"}, fieldErrors(t, req.Validate()))
+
+	nested := map[string]interface{}{}
+	for i := 0; i < 50; i++ {
+		nested[strings.Repeat("n", i+1)] = i
+	}
+	req.Metadata = map[string]interface{}{"nested": nested}
+	assert.Equal(t, []string{"metadata.nested.nnnnnnnnnnnnnnnnnnnnnnnnnnnnnnnnnnnnnnnnn"}, fieldErrors(t, req.Validate())[:1])
+	assert.Contains(t, req.Validate().Error(), "can have at most 50 nested keys, got 51")
+}
+
+func TestCreateEmbeddedRequestValidate(t *testing.T) {
+	checked := false
+	valid := model.CreateEmbeddedRequest{
+		ClientId: "ddddb5e5c34b929957e24b17aa52dddd",
+		FileUrls: []string{"https://example.org/contract.pdf"},
+		Signers:  []model.SubSignatureRequestSigner{{Name: "Signer One", EmailAddress: "signer.one@example.org"}},
+		FormFieldsPerDocument: []model.SubFormFieldsPerDocument{
+			{ApiId: "agree", Type: model.FormFieldTypeCheckbox, Signer: "0", IsChecked: &checked},
+			{ApiId: "terms", Type: model.FormFieldTypeHyperlink, Signer: "0", Content: "Terms", ContentUrl: "https://example.org/terms"},
+		},
+		FormFieldRules: []model.SubFormFieldRule{{
+			Id:              "rule",
+			TriggerOperator: "AND",
+			Triggers:        []model.SubFormFieldRuleTrigger{{Id: "agree", Operator: "is", Value: "1"}},
+			Actions:         []model.SubFormFieldRuleAction{{Hidden: true, Type: "change-field-visibility", FieldId: "terms"}},
+		}},
+	}
+	assert.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.FileUrls = nil
+	invalid.GroupedSigners = []model.SubSignatureRequestGroupedSigners{{Group: "Parents"}}
+	invalid.FormFieldsPerDocument = []model.SubFormFieldsPerDocument{
+		{ApiId: "agree", Type: model.FormFieldTypeCheckbox, Signer: "0"},
+		{ApiId: "agree", Type: model.FormFieldTypeDropdown, Signer: "0"},
+		{ApiId: "color", Type: "colour", Signer: "0"},
+	}
+	invalid.FormFieldRules = []model.SubFormFieldRule{{
+		Id:              "rule",
+		TriggerOperator: "OR",
+		Triggers:        []model.SubFormFieldRuleTrigger{{Id: "agree", Operator: "is"}},
+		Actions:         []model.SubFormFieldRuleAction{{Type: "change-field-visibility", FieldId: "terms", GroupId: "group"}},
+	}}
+	assert.Equal(t, []string{
+		"files",
+		"signers",
+		"grouped_signers[0].signers",
+		"form_fields_per_document[0].is_checked",
+		"form_fields_per_document[1].api_id",
+		"form_fields_per_document[1].options",
+		"form_fields_per_document[2].type",
+		"form_field_rules[0].trigger_operator",
+		"form_field_rules[0].triggers[0]",
+		"form_field_rules[0].actions[0]",
+	}, fieldErrors(t, invalid.Validate()))
+}
+
+func TestUpdateSignatureRequestRequestValidate(t *testing.T) {
+	assert.NoError(t, model.UpdateSignatureRequestRequest{SignatureId: "2f9781e1a8e2045224d808c153c2e1d3df6f8f2f", Name: "Signer Uno"}.Validate())
+	assert.Equal(t, []string{"signature_id", "email_address"}, fieldErrors(t, model.UpdateSignatureRequestRequest{}.Validate()))
+}
+
+func TestUnclaimedDraftCreateRequestValidate(t *testing.T) {
+	valid := model.UnclaimedDraftCreateRequest{
+		Type:     model.UnclaimedDraftTypeSendDocument,
+		FileUrls: []string{"https://example.org/contract.pdf"},
+	}
+	assert.NoError(t, valid.Validate())
+
+	invalid := model.UnclaimedDraftCreateRequest{
+		Type:    "sign",
+		Signers: []model.SubUnclaimedDraftSigner{{Name: "Jack"}},
+	}
+	assert.Equal(t, []string{"type", "files", "signers[0].email_address"}, fieldErrors(t, invalid.Validate()))
+}
@@ -0,0 +1,271 @@
+package hellosign
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sean-rn/hellosign-sdk/model"
+)
+
+// formField is a single name=value pair of a multipart/form-data body
+type formField struct {
+	name  string
+	value string
+}
+
+// formFile is a file part of a multipart/form-data body
+type formFile struct {
+	name string
+	file *model.File
+}
+
+// formParts holds the encoded parts of a request body
+type formParts struct {
+	fields []formField
+	files  []formFile
+}
+
+var (
+	fileType          = reflect.TypeOf((*model.File)(nil))
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// newRequest creates a request with an optional body, encoded as multipart/form-data if it carries any
+// [model.File], or as JSON otherwise.  The body is validated first, as with newJSONRequest.
+func (c *Client) newRequest(ctx context.Context, method, url string, body any) (*http.Request, error) {
+	if body == nil {
+		return c.newJSONRequest(ctx, method, url, nil)
+	}
+	parts, err := encodeForm(body)
+	if err != nil {
+		return nil, fmt.Errorf("encoding body: %w", err)
+	}
+	if len(parts.files) == 0 {
+		return c.newJSONRequest(ctx, method, url, body)
+	}
+	if err := c.validate(body); err != nil {
+		return nil, err
+	}
+	return c.newMultipartRequest(ctx, method, url, parts)
+}
+
+// newMultipartRequest creates a request whose body is streamed as multipart/form-data.  The body can be
+// replayed (via GetBody) when all its files can be read more than once.
+func (c *Client) newMultipartRequest(ctx context.Context, method, url string, parts *formParts) (*http.Request, error) {
+	boundary := multipart.NewWriter(nil).Boundary()
+	getBody := func() (io.ReadCloser, error) {
+		pr, pw := io.Pipe()
+		go func() {
+			pw.CloseWithError(parts.write(pw, boundary))
+		}()
+		return pr, nil
+	}
+
+	body, _ := getBody()
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		body.Close() // Stops the goroutine writing the body
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "multipart/form-data; boundary="+boundary)
+	if parts.replayable() {
+		req.GetBody = getBody
+	}
+	return req, nil
+}
+
+// write writes the parts as a multipart/form-data body
+func (p *formParts) write(w io.Writer, boundary string) error {
+	mw := multipart.NewWriter(w)
+	if err := mw.SetBoundary(boundary); err != nil {
+		return err
+	}
+	for _, field := range p.fields {
+		if err := mw.WriteField(field.name, field.value); err != nil {
+			return err
+		}
+	}
+	for _, f := range p.files {
+		if err := writeFilePart(mw, f); err != nil {
+			return fmt.Errorf("writing %s: %w", f.name, err)
+		}
+	}
+	return mw.Close()
+}
+
+// replayable reports whether the body can be written more than once
+func (p *formParts) replayable() bool {
+	for _, f := range p.files {
+		if !f.file.Replayable() {
+			return false
+		}
+	}
+	return true
+}
+
+// writeFilePart streams the contents of a file as a part of mw
+func writeFilePart(mw *multipart.Writer, f formFile) error {
+	contentType := f.file.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(f.file.Name))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{"name": f.name, "filename": f.file.Name}))
+	header.Set("Content-Type", contentType)
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	contents, err := f.file.Open()
+	if err != nil {
+		return err
+	}
+	defer contents.Close()
+	_, err = io.Copy(part, contents)
+	return err
+}
+
+// encodeForm flattens body into form fields named after the JSON names of its fields, with nested values in the
+// bracket convention used by the API, e.g. `signers[0][name]` or `metadata[key]`.
+func encodeForm(body any) (*formParts, error) {
+	parts := new(formParts)
+	if err := parts.add("", reflect.ValueOf(body)); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
+
+// add appends the encoding of v, named name, to the parts
+func (p *formParts) add(name string, v reflect.Value) error {
+	for v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil
+	}
+
+	if v.Type() == fileType {
+		p.files = append(p.files, formFile{name: name, file: v.Interface().(*model.File)})
+		return nil
+	}
+	if v.Type().Implements(jsonMarshalerType) {
+		return p.addMarshaler(name, v.Interface().(json.Marshaler))
+	}
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		return p.addStruct(name, v)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := p.add(formKey(name, strconv.Itoa(i)), v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		for _, key := range keys {
+			if err := p.add(formKey(name, fmt.Sprint(key)), v.MapIndex(key)); err != nil {
+				return err
+			}
+		}
+	case reflect.String:
+		p.fields = append(p.fields, formField{name, v.String()})
+	case reflect.Bool:
+		p.fields = append(p.fields, formField{name, strconv.FormatBool(v.Bool())})
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		p.fields = append(p.fields, formField{name, strconv.FormatInt(v.Int(), 10)})
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		p.fields = append(p.fields, formField{name, strconv.FormatUint(v.Uint(), 10)})
+	case reflect.Float32, reflect.Float64:
+		p.fields = append(p.fields, formField{name, strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits())})
+	default:
+		return fmt.Errorf("%s: unsupported type %s", name, v.Type())
+	}
+	return nil
+}
+
+// addStruct appends the exported fields of struct v, named after their JSON names
+func (p *formParts) addStruct(name string, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		fieldName, opts, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if fieldName == "-" && opts == "" {
+			continue
+		}
+		if fieldName == "" {
+			fieldName = sf.Name
+		}
+		fv := v.Field(i)
+		if strings.Contains(","+opts+",", ",omitempty,") && isEmptyValue(fv) {
+			continue
+		}
+		if err := p.add(formKey(name, fieldName), fv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addMarshaler appends the JSON encoding of m, unquoted if it is a JSON string
+func (p *formParts) addMarshaler(name string, m json.Marshaler) error {
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	value := string(data)
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = unquoted
+	}
+	p.fields = append(p.fields, formField{name, value})
+	return nil
+}
+
+// formKey returns the name of the field key nested in the field named prefix
+func formKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "[" + key + "]"
+}
+
+// isEmptyValue reports whether v is empty as defined by the omitempty option of encoding/json
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
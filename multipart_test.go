@@ -0,0 +1,81 @@
+package hellosign_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/sean-rn/hellosign-sdk"
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultipartUpload(t *testing.T) {
+	var form map[string][]string
+	files := map[string]string{}
+	var contentTypes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !assert.NoError(t, r.ParseMultipartForm(1<<20)) {
+			http.Error(w, "bad form", http.StatusBadRequest)
+			return
+		}
+		form = r.MultipartForm.Value
+		for name, headers := range r.MultipartForm.File {
+			f, err := headers[0].Open()
+			if !assert.NoError(t, err) {
+				http.Error(w, "bad file", http.StatusBadRequest)
+				return
+			}
+			data, err := io.ReadAll(f)
+			assert.NoError(t, err)
+			files[name] = headers[0].Filename + ":" + string(data)
+			contentTypes = append(contentTypes, headers[0].Header.Get("Content-Type"))
+		}
+		http.ServeFile(w, r, "testdata/create_embedded_with_template.resp.json")
+	}))
+	t.Cleanup(server.Close)
+
+	fsys := fstest.MapFS{"docs/appendix.txt": {Data: []byte("appendix")}}
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	contract := model.FileFromBytes("contract", []byte("%PDF-1.4"))
+	contract.ContentType = "application/pdf"
+	_, err := client.CreateEmbeddedWithTemplate(context.Background(), model.CreateEmbeddedWithTemplateRequest{
+		ClientId:    "ddddb5e5c34b929957e24b17aa52dddd",
+		TemplateIds: []string{"cccc6ad681229567aab20cd83a69cf18fb2cccc"},
+		Signers: []model.SubSignatureRequestTemplateSigner{
+			{Role: "First", Name: "Signer One", EmailAddress: "signer.one@example.org"},
+		},
+		Files: []*model.File{
+			model.FileFromFS(fsys, "docs/appendix.txt"),
+			model.FileFromReader("stream.bin", strings.NewReader("streamed")),
+			contract,
+		},
+		Metadata:       map[string]interface{}{"partner_user_id": 3456},
+		SigningOptions: &model.SubSigningOptions{DefaultType: "draw"},
+		TestMode:       true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ddddb5e5c34b929957e24b17aa52dddd"}, form["client_id"])
+	assert.Equal(t, []string{"cccc6ad681229567aab20cd83a69cf18fb2cccc"}, form["template_ids[0]"])
+	assert.Equal(t, []string{"First"}, form["signers[0][role]"])
+	assert.Equal(t, []string{"signer.one@example.org"}, form["signers[0][email_address]"])
+	assert.Equal(t, []string{"3456"}, form["metadata[partner_user_id]"])
+	assert.Equal(t, []string{"draw"}, form["signing_options[default_type]"])
+	assert.Equal(t, []string{"true"}, form["test_mode"])
+	assert.NotContains(t, form, "allow_decline")
+	assert.NotContains(t, form, "signers[0][pin]")
+
+	assert.Equal(t, map[string]string{
+		"files[0]": "appendix.txt:appendix",
+		"files[1]": "stream.bin:streamed",
+		"files[2]": "contract:%PDF-1.4",
+	}, files)
+	assert.ElementsMatch(t, []string{"text/plain; charset=utf-8", "application/octet-stream", "application/pdf"}, contentTypes)
+}
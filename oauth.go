@@ -0,0 +1,244 @@
+package hellosign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultOAuthAuthorizeURL is the page users are sent to to authorize an app to act on their behalf
+	DefaultOAuthAuthorizeURL = "https://app.hellosign.com/oauth/authorize"
+	// DefaultOAuthTokenURL is the endpoint issuing and refreshing access tokens
+	DefaultOAuthTokenURL = "https://app.hellosign.com/oauth/token"
+
+	// tokenExpiryDelta is how long before their expiry tokens are refreshed, so that they do not expire in flight
+	tokenExpiryDelta = time.Minute
+)
+
+// Token is an OAuth 2.0 token issued to an app on behalf of a user.  Its fields mirror those of the Token of
+// golang.org/x/oauth2, so tokens can be converted from one to the other, or persisted using the same JSON encoding.
+type Token struct {
+	// AccessToken authenticates the requests sent on behalf of the user.
+	AccessToken string `json:"access_token"`
+	// TokenType is the type of AccessToken, "Bearer" if empty.
+	TokenType string `json:"token_type,omitempty"`
+	// RefreshToken is used to obtain a new access token when AccessToken expires.
+	RefreshToken string `json:"refresh_token,omitempty"`
+	// Expiry is when AccessToken expires, the zero time if it does not.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// Type returns the type of the access token, defaulting to "Bearer".
+func (t *Token) Type() string {
+	if t.TokenType == "" || strings.EqualFold(t.TokenType, "bearer") {
+		return "Bearer"
+	}
+	return t.TokenType
+}
+
+// Valid reports whether t is non-nil, has an access token and is not about to expire.
+func (t *Token) Valid() bool {
+	return t != nil && t.AccessToken != "" && (t.Expiry.IsZero() || time.Now().Add(tokenExpiryDelta).Before(t.Expiry))
+}
+
+// TokenSource supplies the tokens used to authenticate requests, see [WithTokenSource].  It has the semantics of the
+// TokenSource of golang.org/x/oauth2: Token returns a valid token, and implementations must be safe for concurrent use.
+type TokenSource interface {
+	Token() (*Token, error)
+}
+
+// TokenSourceFunc adapts a function to a TokenSource, e.g. to use an oauth2.TokenSource:
+//
+//	hellosign.TokenSourceFunc(func() (*hellosign.Token, error) {
+//		t, err := ts.Token()
+//		if err != nil {
+//			return nil, err
+//		}
+//		return &hellosign.Token{AccessToken: t.AccessToken, TokenType: t.TokenType, Expiry: t.Expiry}, nil
+//	})
+type TokenSourceFunc func() (*Token, error)
+
+// Token implements TokenSource
+func (f TokenSourceFunc) Token() (*Token, error) {
+	return f()
+}
+
+// WithTokenSource configures the client to authenticate using the access tokens supplied by ts, such as the
+// refreshing TokenSource of an [OAuthConfig], to send API requests on behalf of the user that granted authorization.
+func WithTokenSource(ts TokenSource) Option {
+	return WithAuthenticator(TokenSourceAuthenticator(ts))
+}
+
+// OAuthConfig describes an app authorized by users to act on their behalf with the OAuth 2.0 authorization code flow.
+// See the [OAuth walkthrough].
+//
+// [OAuth walkthrough]: https://developers.hellosign.com/docs/o-auth/walkthrough/
+type OAuthConfig struct {
+	ClientId     string   // Client id of the app.
+	ClientSecret string   // Secret of the app, used to exchange codes for tokens and to refresh them.
+	Scopes       []string // Scopes requested when authorizing the app, those configured for the app if empty.
+
+	AuthorizeURL string       // URL of the authorization page, DefaultOAuthAuthorizeURL if empty.
+	TokenURL     string       // URL of the token endpoint, DefaultOAuthTokenURL if empty.
+	HTTPClient   *http.Client // Client used to call the token endpoint, http.DefaultClient if nil.
+
+	// OnRefresh, if set, is called with each token obtained by a TokenSource refreshing its token, e.g. to persist it.
+	OnRefresh func(*Token)
+}
+
+// AuthCodeURL returns the URL of the page asking the user to authorize the app.  Once they do, they are redirected to
+// the callback URL of the app with `code` and `state` query parameters, to pass to Exchange.  state should be an
+// unguessable value bound to the user's session, protecting against CSRF.
+func (c *OAuthConfig) AuthCodeURL(state string) string {
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", c.ClientId)
+	query.Set("state", state)
+	if len(c.Scopes) > 0 {
+		query.Set("scope", strings.Join(c.Scopes, " "))
+	}
+	authorizeURL := c.AuthorizeURL
+	if authorizeURL == "" {
+		authorizeURL = DefaultOAuthAuthorizeURL
+	}
+	return withQuery(authorizeURL, query.Encode())
+}
+
+// Exchange exchanges the code received by the callback URL of the app, with the state it was issued for, for a token.
+func (c *OAuthConfig) Exchange(ctx context.Context, code, state string) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("state", state)
+	form.Set("client_id", c.ClientId)
+	form.Set("client_secret", c.ClientSecret)
+	return c.requestToken(ctx, c.tokenURL(), form)
+}
+
+// Refresh obtains a new token using refreshToken.  The returned token keeps refreshToken if the API does not issue a
+// new one.
+func (c *OAuthConfig) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	if refreshToken == "" {
+		return nil, errors.New("token has no refresh token")
+	}
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+	if c.ClientId != "" && c.ClientSecret != "" {
+		form.Set("client_id", c.ClientId)
+		form.Set("client_secret", c.ClientSecret)
+	}
+	t, err := c.requestToken(ctx, withQuery(c.tokenURL(), "refresh"), form)
+	if err != nil {
+		return nil, err
+	}
+	if t.RefreshToken == "" {
+		t.RefreshToken = refreshToken
+	}
+	return t, nil
+}
+
+// TokenSource returns a TokenSource returning t until it is about to expire, and then tokens refreshed with its
+// refresh token.  Refreshing uses ctx, so it should outlive the TokenSource.  The TokenSource is safe for concurrent
+// use, refreshing the token only once when several requests need it at the same time.
+func (c *OAuthConfig) TokenSource(ctx context.Context, t *Token) TokenSource {
+	return &refreshingTokenSource{ctx: ctx, config: c, token: t}
+}
+
+// tokenURL returns the URL of the token endpoint
+func (c *OAuthConfig) tokenURL() string {
+	if c.TokenURL != "" {
+		return c.TokenURL
+	}
+	return DefaultOAuthTokenURL
+}
+
+// withQuery appends query to rawURL, which may already have a query string
+func withQuery(rawURL, query string) string {
+	sep := "?"
+	if strings.Contains(rawURL, "?") {
+		sep = "&"
+	}
+	return rawURL + sep + query
+}
+
+// tokenResponse is the response of the token endpoint
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"` // Lifetime of the access token, in seconds.
+}
+
+// requestToken posts form to the token endpoint and returns the token it issues
+func (c *OAuthConfig) requestToken(ctx context.Context, tokenURL string, form url.Values) (*Token, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, newAPIError(resp)
+	}
+
+	var tr tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return nil, fmt.Errorf("decoding token: %w", err)
+	}
+	if tr.AccessToken == "" {
+		return nil, errors.New("token endpoint returned no access token")
+	}
+	t := &Token{AccessToken: tr.AccessToken, TokenType: tr.TokenType, RefreshToken: tr.RefreshToken}
+	if tr.ExpiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+	return t, nil
+}
+
+// refreshingTokenSource is the TokenSource of an OAuthConfig
+type refreshingTokenSource struct {
+	ctx    context.Context
+	config *OAuthConfig
+
+	mu    sync.Mutex // Serializes refreshes, and guards token
+	token *Token
+}
+
+// Token implements TokenSource
+func (s *refreshingTokenSource) Token() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token.Valid() {
+		return s.token, nil
+	}
+	if s.token == nil {
+		return nil, ErrNoCredentials
+	}
+
+	t, err := s.config.Refresh(s.ctx, s.token.RefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("refreshing token: %w", err)
+	}
+	s.token = t
+	if s.config.OnRefresh != nil {
+		s.config.OnRefresh(t)
+	}
+	return t, nil
+}
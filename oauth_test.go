@@ -0,0 +1,172 @@
+package hellosign_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sean-rn/hellosign-sdk"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthAuthCodeURL(t *testing.T) {
+	config := &hellosign.OAuthConfig{ClientId: "cc91c61d00f8bb2ece1428035716b", Scopes: []string{"basic_account_info", "request_signature"}}
+	u, err := url.Parse(config.AuthCodeURL("900e06e2"))
+	require.NoError(t, err)
+	assert.Equal(t, "app.hellosign.com", u.Host)
+	assert.Equal(t, "/oauth/authorize", u.Path)
+	assert.Equal(t, url.Values{
+		"response_type": {"code"},
+		"client_id":     {"cc91c61d00f8bb2ece1428035716b"},
+		"state":         {"900e06e2"},
+		"scope":         {"basic_account_info request_signature"},
+	}, u.Query())
+}
+
+func TestOAuthExchange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oauth/token", r.URL.Path)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {"1b0d28d90c86c141"},
+			"state":         {"900e06e2"},
+			"client_id":     {"cc91c61d00f8bb2ece1428035716b"},
+			"client_secret": {"1d14434088507ffa390e6f5528465"},
+		}, r.PostForm)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "NWNiOTMxOGFkOGVjMDhhNTAxZN2NkNjgxMjMwOWJiYTEzZTBmZGUzMjMThhMzYyMzc=",
+			"token_type": "Bearer", "refresh_token": "hNTI2MTFmM2VmZDQxZTZjOWRmZmFjZmVmMGMyNGFjMzI2MGI5YzgzNmE3",
+			"expires_in": 86400, "state": null}`))
+	}))
+	t.Cleanup(server.Close)
+
+	config := &hellosign.OAuthConfig{
+		ClientId:     "cc91c61d00f8bb2ece1428035716b",
+		ClientSecret: "1d14434088507ffa390e6f5528465",
+		TokenURL:     server.URL + "/oauth/token",
+	}
+	token, err := config.Exchange(context.Background(), "1b0d28d90c86c141", "900e06e2")
+	require.NoError(t, err)
+	assert.Equal(t, "NWNiOTMxOGFkOGVjMDhhNTAxZN2NkNjgxMjMwOWJiYTEzZTBmZGUzMjMThhMzYyMzc=", token.AccessToken)
+	assert.Equal(t, "hNTI2MTFmM2VmZDQxZTZjOWRmZmFjZmVmMGMyNGFjMzI2MGI5YzgzNmE3", token.RefreshToken)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), token.Expiry, time.Minute)
+	assert.True(t, token.Valid())
+}
+
+func TestOAuthExchangeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": {"error_msg": "Invalid code", "error_name": "invalid_grant"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	config := &hellosign.OAuthConfig{ClientId: "cc91c61d00f8bb2ece1428035716b", TokenURL: server.URL}
+	_, err := config.Exchange(context.Background(), "bad", "900e06e2")
+	var apiErr *hellosign.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "invalid_grant", apiErr.ErrorName)
+}
+
+func TestWithTokenSourceRefreshes(t *testing.T) {
+	var refreshes atomic.Int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Contains(t, r.URL.Query(), "refresh")
+		assert.Equal(t, "sign", r.URL.Query().Get("tenant"))
+		assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "old-refresh-token", r.PostForm.Get("refresh_token"))
+		refreshes.Add(1)
+		time.Sleep(10 * time.Millisecond) // Let concurrent requests pile up behind the refresh
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "new-access-token", "token_type": "Bearer", "expires_in": 3600}`))
+	}))
+	t.Cleanup(tokenServer.Close)
+
+	var mu sync.Mutex
+	var authorizations []string
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"signature_request": {"signature_request_id": "fa5c8a0b0f492d768749333ad6fcc214c111e967"}}`))
+	}))
+	t.Cleanup(apiServer.Close)
+
+	var persisted *hellosign.Token
+	config := &hellosign.OAuthConfig{TokenURL: tokenServer.URL + "?tenant=sign", OnRefresh: func(t *hellosign.Token) { persisted = t }}
+	expiring := &hellosign.Token{AccessToken: "old-access-token", RefreshToken: "old-refresh-token", Expiry: time.Now().Add(30 * time.Second)}
+	client := hellosign.NewClient(hellosign.WithBaseURL(apiServer.URL),
+		hellosign.WithTokenSource(config.TokenSource(context.Background(), expiring)))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetSignatureRequest(context.Background(), "fa5c8a0b0f492d768749333ad6fcc214c111e967")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), refreshes.Load(), "the token must be refreshed once")
+	assert.Equal(t, []string{"Bearer new-access-token", "Bearer new-access-token", "Bearer new-access-token",
+		"Bearer new-access-token", "Bearer new-access-token"}, authorizations)
+	require.NotNil(t, persisted)
+	assert.Equal(t, "new-access-token", persisted.AccessToken)
+	assert.Equal(t, "old-refresh-token", persisted.RefreshToken, "the refresh token is kept when no new one is issued")
+}
+
+func TestWithTokenSourceError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request must be sent without a token")
+	}))
+	t.Cleanup(server.Close)
+
+	config := &hellosign.OAuthConfig{TokenURL: server.URL}
+	expired := &hellosign.Token{AccessToken: "old-access-token", Expiry: time.Now().Add(-time.Hour)}
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithTokenSource(config.TokenSource(context.Background(), expired)))
+	_, err := client.GetSignatureRequest(context.Background(), "fa5c8a0b0f492d768749333ad6fcc214c111e967")
+	assert.ErrorContains(t, err, "token has no refresh token")
+}
+
+func TestWithTokenSourceRefreshesBetweenRetries(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "new-access-token", "token_type": "Bearer", "expires_in": 3600}`))
+	}))
+	t.Cleanup(tokenServer.Close)
+
+	var authorizations []string
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		if len(authorizations) == 1 {
+			http.Error(w, `{"error": {"error_msg": "Try again", "error_name": "unavailable"}}`, http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"signature_request": {"signature_request_id": "fa5c8a0b0f492d768749333ad6fcc214c111e967"}}`))
+	}))
+	t.Cleanup(apiServer.Close)
+
+	// The token is valid for the first attempt, but about to expire by the time of the retry
+	config := &hellosign.OAuthConfig{TokenURL: tokenServer.URL}
+	expiring := &hellosign.Token{AccessToken: "old-access-token", RefreshToken: "old-refresh-token", Expiry: time.Now().Add(time.Minute + 200*time.Millisecond)}
+	client := hellosign.NewClient(hellosign.WithBaseURL(apiServer.URL),
+		hellosign.WithTokenSource(config.TokenSource(context.Background(), expiring)),
+		hellosign.WithRetryPolicy(hellosign.RetryPolicy{InitialBackoff: 400 * time.Millisecond}))
+
+	_, err := client.GetSignatureRequest(context.Background(), "fa5c8a0b0f492d768749333ad6fcc214c111e967")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer old-access-token", "Bearer new-access-token"}, authorizations)
+}
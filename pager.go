@@ -0,0 +1,164 @@
+package hellosign
+
+import (
+	"context"
+
+	"github.com/sean-rn/hellosign-sdk/model"
+)
+
+// PageFetcher fetches one page (numbered from 1) of a list endpoint, returning its items and `list_info`.
+type PageFetcher[T any] func(ctx context.Context, page int) ([]T, model.ListInfoResponse, error)
+
+// PagerOptions configures a Pager.
+type PagerOptions struct {
+	StartPage int  // The first page to fetch.  Defaults to 1.
+	MaxItems  int  // Stop after returning this many items in total.  Zero means no limit.
+	Prefetch  bool // Fetch the next page in the background while the items of the current page are consumed.
+}
+
+// Pager walks the pages of a list endpoint, fetching each page only when the items of the previous one are used up.
+// It stops at the last page reported by `list_info`, on the first error, or when the context is canceled.
+//
+// Use it like a [bufio.Scanner]:
+//
+//	pager := client.ListSignatureRequestsPager(ctx, model.ListSignatureRequestsRequest{}, hellosign.PagerOptions{})
+//	defer pager.Close()
+//	for pager.Next() {
+//		sr := pager.Item()
+//		...
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+//
+// With Go 1.23 or later [Pager.All] can be used with a for-range loop instead.
+// A Pager is not safe for concurrent use.
+type Pager[T any] struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	fetch  PageFetcher[T]
+	opts   PagerOptions
+
+	items   []T                    // Items of the current page
+	idx     int                    // Index in items of the next item to return
+	item    T                      // The item returned by Item
+	count   int                    // Number of items returned so far
+	page    int                    // Number of the page last fetched, 0 if none yet
+	info    model.ListInfoResponse // `list_info` of the page last fetched
+	pending chan pageResult[T]     // Result of the page being prefetched, if any
+	done    bool
+	err     error
+}
+
+// pageResult is the outcome of fetching a page
+type pageResult[T any] struct {
+	items []T
+	info  model.ListInfoResponse
+	err   error
+}
+
+// NewPager creates a Pager fetching pages with fetch.  No page is fetched until Next is called.
+func NewPager[T any](ctx context.Context, fetch PageFetcher[T], opts PagerOptions) *Pager[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	p := &Pager[T]{ctx: ctx, cancel: cancel, fetch: fetch, opts: opts}
+	if opts.StartPage > 1 {
+		p.page = opts.StartPage - 1
+	}
+	return p
+}
+
+// Next advances to the next item, fetching the next page if needed.  It returns false when there are no more
+// items or an error occurred, after which Err reports the error, if any.
+func (p *Pager[T]) Next() bool {
+	if p.done {
+		return false
+	}
+	if p.opts.MaxItems > 0 && p.count >= p.opts.MaxItems {
+		p.finish(nil)
+		return false
+	}
+	for p.idx >= len(p.items) {
+		if p.items != nil && (len(p.items) == 0 || p.page >= p.info.NumPages) {
+			p.finish(nil)
+			return false
+		}
+		res := p.nextPage()
+		if res.err != nil {
+			p.finish(res.err)
+			return false
+		}
+		p.items, p.idx, p.info = res.items, 0, res.info
+		if p.items == nil {
+			p.items = []T{}
+		}
+	}
+
+	p.item = p.items[p.idx]
+	p.idx++
+	p.count++
+	return true
+}
+
+// Item returns the item Next advanced to.
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+// Err returns the error that stopped the Pager, or nil if it stopped because there were no more items.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// ListInfo returns the `list_info` of the page last fetched.
+func (p *Pager[T]) ListInfo() model.ListInfoResponse {
+	return p.info
+}
+
+// Close stops the Pager, canceling any page being prefetched.  It is not needed once Next has returned false.
+func (p *Pager[T]) Close() {
+	p.finish(p.err)
+}
+
+// nextPage fetches (or waits for the prefetch of) the page after the current one, and starts prefetching the
+// page after that if configured to.
+func (p *Pager[T]) nextPage() pageResult[T] {
+	var res pageResult[T]
+	if p.pending != nil {
+		select {
+		case res = <-p.pending:
+		case <-p.ctx.Done():
+			res.err = p.ctx.Err()
+		}
+		p.pending = nil
+	} else {
+		res = p.fetchPage(p.page + 1)
+	}
+	p.page++
+
+	remaining := p.opts.MaxItems - p.count - len(res.items)
+	if res.err == nil && p.opts.Prefetch && p.page < res.info.NumPages && (p.opts.MaxItems == 0 || remaining > 0) {
+		pending := make(chan pageResult[T], 1)
+		go func(page int) {
+			pending <- p.fetchPage(page)
+		}(p.page + 1)
+		p.pending = pending
+	}
+	return res
+}
+
+// fetchPage calls the PageFetcher unless the context is already done
+func (p *Pager[T]) fetchPage(page int) pageResult[T] {
+	if err := p.ctx.Err(); err != nil {
+		return pageResult[T]{err: err}
+	}
+	items, info, err := p.fetch(p.ctx, page)
+	return pageResult[T]{items: items, info: info, err: err}
+}
+
+// finish stops the Pager with err
+func (p *Pager[T]) finish(err error) {
+	p.done = true
+	p.err = err
+	p.pending = nil
+	p.cancel()
+}
@@ -0,0 +1,31 @@
+//go:build go1.23
+
+package hellosign
+
+import "iter"
+
+// All returns an iterator over the remaining items of the Pager, for use with a for-range loop:
+//
+//	for sr, err := range client.ListSignatureRequestsPager(ctx, model.ListSignatureRequestsRequest{}, hellosign.PagerOptions{}).All() {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// If the Pager stops because of an error, it is yielded (with the zero value of T) as the final pair.
+// The Pager is closed when the loop ends, including when breaking out of it early.
+func (p *Pager[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer p.Close()
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if err := p.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
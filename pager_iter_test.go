@@ -0,0 +1,30 @@
+//go:build go1.23
+
+package hellosign_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sean-rn/hellosign-sdk"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagerAll(t *testing.T) {
+	fetch, fetched := newTestFetcher(3, 2)
+	pager := hellosign.NewPager(context.Background(), fetch, hellosign.PagerOptions{})
+
+	var got []int
+	for item, err := range pager.All() {
+		require.NoError(t, err)
+		if item == 3 {
+			break
+		}
+		got = append(got, item)
+	}
+	assert.Equal(t, []int{0, 1, 2}, got)
+	assert.Equal(t, []int{1, 2}, *fetched)
+	assert.False(t, pager.Next())
+}
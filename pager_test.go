@@ -0,0 +1,111 @@
+package hellosign_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sean-rn/hellosign-sdk"
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestFetcher returns a PageFetcher serving numPages pages of pageSize ints, and a slice recording the pages fetched
+func newTestFetcher(numPages, pageSize int) (hellosign.PageFetcher[int], *[]int) {
+	var fetched []int
+	return func(ctx context.Context, page int) ([]int, model.ListInfoResponse, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, model.ListInfoResponse{}, err
+		}
+		fetched = append(fetched, page)
+		items := make([]int, pageSize)
+		for i := range items {
+			items[i] = (page-1)*pageSize + i
+		}
+		return items, model.ListInfoResponse{NumPages: numPages, NumResults: numPages * pageSize, Page: page, PageSize: pageSize}, nil
+	}, &fetched
+}
+
+// collect drains a pager
+func collect(pager *hellosign.Pager[int]) []int {
+	var got []int
+	for pager.Next() {
+		got = append(got, pager.Item())
+	}
+	return got
+}
+
+func TestPager(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("walks all pages lazily", func(t *testing.T) {
+		fetch, fetched := newTestFetcher(3, 2)
+		pager := hellosign.NewPager(ctx, fetch, hellosign.PagerOptions{})
+		assert.Empty(t, *fetched)
+		require.True(t, pager.Next())
+		assert.Equal(t, []int{1}, *fetched)
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, collect(pager))
+		assert.NoError(t, pager.Err())
+		assert.Equal(t, []int{1, 2, 3}, *fetched)
+		assert.Equal(t, 3, pager.ListInfo().Page)
+	})
+
+	t.Run("caps items", func(t *testing.T) {
+		fetch, fetched := newTestFetcher(3, 2)
+		pager := hellosign.NewPager(ctx, fetch, hellosign.PagerOptions{MaxItems: 3, Prefetch: true})
+		assert.Equal(t, []int{0, 1, 2}, collect(pager))
+		assert.NoError(t, pager.Err())
+		assert.Equal(t, []int{1, 2}, *fetched)
+	})
+
+	t.Run("starts at a later page", func(t *testing.T) {
+		fetch, _ := newTestFetcher(3, 2)
+		pager := hellosign.NewPager(ctx, fetch, hellosign.PagerOptions{StartPage: 3})
+		assert.Equal(t, []int{4, 5}, collect(pager))
+	})
+
+	t.Run("prefetches the next page", func(t *testing.T) {
+		fetch, _ := newTestFetcher(3, 2)
+		release := make(chan struct{})
+		var fetches []int
+		blocking := func(ctx context.Context, page int) ([]int, model.ListInfoResponse, error) {
+			if page > 1 {
+				<-release
+			}
+			fetches = append(fetches, page)
+			return fetch(ctx, page)
+		}
+		pager := hellosign.NewPager(ctx, blocking, hellosign.PagerOptions{Prefetch: true})
+		require.True(t, pager.Next())
+		close(release)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, collect(pager))
+		assert.Equal(t, []int{1, 2, 3}, fetches)
+	})
+
+	t.Run("stops on error", func(t *testing.T) {
+		fetch, _ := newTestFetcher(3, 2)
+		failing := func(ctx context.Context, page int) ([]int, model.ListInfoResponse, error) {
+			if page == 2 {
+				return nil, model.ListInfoResponse{}, errors.New("boom")
+			}
+			return fetch(ctx, page)
+		}
+		pager := hellosign.NewPager(ctx, failing, hellosign.PagerOptions{Prefetch: true})
+		assert.Equal(t, []int{0, 1}, collect(pager))
+		assert.EqualError(t, pager.Err(), "boom")
+		assert.False(t, pager.Next())
+	})
+
+	t.Run("honours cancellation", func(t *testing.T) {
+		cctx, cancel := context.WithCancel(ctx)
+		fetch, _ := newTestFetcher(3, 2)
+		pager := hellosign.NewPager(cctx, fetch, hellosign.PagerOptions{})
+		require.True(t, pager.Next())
+		cancel()
+		assert.Equal(t, []int{1}, collect(pager))
+		assert.ErrorIs(t, pager.Err(), context.Canceled)
+	})
+}
@@ -0,0 +1,171 @@
+package hellosign
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRateLimit is the number of requests per minute allowed by the API for standard accounts.  Test mode
+	// requests are limited to 10 per minute, and higher tier accounts may be allowed more.
+	DefaultRateLimit = 100
+
+	// rateLimitWindow is the period the API rate limit applies to
+	rateLimitWindow = time.Minute
+)
+
+// RateLimit describes the API rate limit as last known by a Client.
+type RateLimit struct {
+	Limit     int       // Number of requests allowed per minute.
+	Remaining int       // Number of requests remaining in the current window.
+	Reset     time.Time // When the current window resets, zero if not reported by the API yet.
+}
+
+// WithRateLimiter configures the client to throttle its requests with a token bucket allowing requestsPerMinute
+// requests per minute (or [DefaultRateLimit] if zero), instead of having them rejected with 429 Too Many Requests.
+// The limiter adjusts itself to the X-Ratelimit-Limit, X-Ratelimit-Limit-Remaining and X-Ratelimit-Reset headers of
+// the responses.  Requests wait for the limiter until their context is done.
+func WithRateLimiter(requestsPerMinute int) Option {
+	return func(c *Client) {
+		if requestsPerMinute <= 0 {
+			requestsPerMinute = DefaultRateLimit
+		}
+		c.limiter = newRateLimiter(requestsPerMinute, time.Now)
+	}
+}
+
+// RateLimit returns the rate limit as last reported by the API, or the zero value if the client was not configured
+// using [WithRateLimiter].
+func (c *Client) RateLimit() RateLimit {
+	if c.limiter == nil {
+		return RateLimit{}
+	}
+	return c.limiter.status()
+}
+
+// sendOnce sends req once, after waiting for the rate limiter if the client has one.  Credentials are added right
+// before sending, so that each attempt uses current ones, e.g. a token refreshed while waiting.
+func (c *Client) sendOnce(req *http.Request) (*http.Response, error) {
+	if c.limiter != nil {
+		if err := c.limiter.wait(req.Context()); err != nil {
+			closeBody(req)
+			return nil, err
+		}
+	}
+	if err := c.authenticate(req); err != nil {
+		closeBody(req)
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err == nil && c.limiter != nil {
+		c.limiter.observe(resp.Header)
+	}
+	return resp, err
+}
+
+// closeBody closes the body of a request which is not sent, as httpClient.Do would, stopping the goroutine writing a
+// multipart body
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+// rateLimiter is a token bucket tuned by the rate limit headers of the API.  It is safe for concurrent use.
+type rateLimiter struct {
+	mu        sync.Mutex
+	now       func() time.Time
+	limit     int       // Requests allowed per window, also the capacity of the bucket
+	tokens    float64   // Requests that can be sent now
+	updated   time.Time // When tokens was last refilled
+	remaining int       // Requests remaining in the window as reported by the API, -1 if unknown
+	reset     time.Time // When the window reported by the API resets
+}
+
+// newRateLimiter creates a rateLimiter with a full bucket
+func newRateLimiter(limit int, now func() time.Time) *rateLimiter {
+	return &rateLimiter{
+		now:       now,
+		limit:     limit,
+		tokens:    float64(limit),
+		updated:   now(),
+		remaining: -1,
+	}
+}
+
+// wait blocks until a request may be sent or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		delay := l.reserve()
+		l.mu.Unlock()
+		if delay <= 0 {
+			return nil
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token if one is available, otherwise returns how long to wait before trying again.
+func (l *rateLimiter) reserve() time.Duration {
+	now := l.refill()
+	if l.remaining == 0 && now.Before(l.reset) {
+		return l.reset.Sub(now)
+	}
+	if l.tokens < 1 {
+		return time.Duration((1 - l.tokens) * float64(rateLimitWindow) / float64(l.limit))
+	}
+	l.tokens--
+	if l.remaining > 0 {
+		l.remaining--
+	}
+	return 0
+}
+
+// refill adds the tokens accumulated since the last refill, returning the current time
+func (l *rateLimiter) refill() time.Time {
+	now := l.now()
+	elapsed := now.Sub(l.updated)
+	l.updated = now
+	if elapsed > 0 {
+		l.tokens = min(float64(l.limit), l.tokens+float64(elapsed)*float64(l.limit)/float64(rateLimitWindow))
+	}
+	return now
+}
+
+// observe tunes the limiter from the rate limit headers of a response.
+func (l *rateLimiter) observe(header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill()
+
+	if limit, err := strconv.Atoi(header.Get("X-Ratelimit-Limit")); err == nil && limit > 0 {
+		l.limit = limit
+		l.tokens = min(l.tokens, float64(limit))
+	}
+	if remaining, err := strconv.Atoi(header.Get("X-Ratelimit-Limit-Remaining")); err == nil && remaining >= 0 {
+		l.remaining = remaining
+		l.tokens = min(l.tokens, float64(remaining))
+	}
+	if reset, err := strconv.ParseInt(header.Get("X-Ratelimit-Reset"), 10, 64); err == nil {
+		l.reset = time.Unix(reset, 0)
+	}
+}
+
+// status returns the current rate limit
+func (l *rateLimiter) status() RateLimit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.refill()
+
+	remaining := int(l.tokens)
+	if l.remaining >= 0 && now.Before(l.reset) {
+		remaining = min(remaining, l.remaining)
+	}
+	return RateLimit{Limit: l.limit, Remaining: remaining, Reset: l.reset}
+}
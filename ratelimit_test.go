@@ -0,0 +1,87 @@
+package hellosign_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sean-rn/hellosign-sdk"
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	reset := time.Now().Add(time.Minute).Truncate(time.Second)
+	remaining := 2
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		remaining--
+		w.Header().Set("X-Ratelimit-Limit", "10")
+		w.Header().Set("X-Ratelimit-Limit-Remaining", strconv.Itoa(remaining))
+		w.Header().Set("X-Ratelimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		http.ServeFile(w, r, "testdata/create_embedded_with_template.resp.json")
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithRateLimiter(0))
+	assert.Equal(t, hellosign.DefaultRateLimit, client.RateLimit().Limit)
+
+	_, err := client.GetSignatureRequest(ctx, "ebaae602348695a4c712aa0f22614986d03caaaa")
+	require.NoError(t, err)
+	assert.Equal(t, hellosign.RateLimit{Limit: 10, Remaining: 1, Reset: reset}, client.RateLimit())
+
+	_, err = client.GetSignatureRequest(ctx, "ebaae602348695a4c712aa0f22614986d03caaaa")
+	require.NoError(t, err)
+	assert.Equal(t, 0, client.RateLimit().Remaining)
+
+	// The quota is used up until reset, so the next request waits until its context expires
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = client.GetSignatureRequest(ctx, "ebaae602348695a4c712aa0f22614986d03caaaa")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 2, requests)
+}
+
+func TestRateLimiterClosesBodyOnCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, "testdata/create_embedded_with_template.resp.json")
+	}))
+	t.Cleanup(server.Close)
+
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithRateLimiter(1))
+	_, err := client.GetSignatureRequest(context.Background(), "ebaae602348695a4c712aa0f22614986d03caaaa")
+	require.NoError(t, err)
+	goroutines := runtime.NumGoroutine()
+
+	// The limiter is empty, so these requests fail while waiting, and must not leak the goroutines writing their body
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < 20; i++ {
+		_, err := client.CreateEmbeddedWithTemplate(ctx, model.CreateEmbeddedWithTemplateRequest{
+			ClientId:    "ddddb5e5c34b929957e24b17aa52dddd",
+			TemplateIds: []string{"cccc6ad681229567aab20cd83a69cf18fb2cccc"},
+			Signers: []model.SubSignatureRequestTemplateSigner{
+				{Role: "First", Name: "Signer One", EmailAddress: "signer.one@example.org"},
+			},
+			Files: []*model.File{model.FileFromBytes("contract.pdf", []byte("%PDF-1.4"))},
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+	for i := 0; i < 100 && runtime.NumGoroutine() > goroutines; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), goroutines)
+}
+
+func TestRateLimitNotConfigured(t *testing.T) {
+	client := hellosign.NewClient()
+	assert.Equal(t, hellosign.RateLimit{}, client.RateLimit())
+}
@@ -0,0 +1,185 @@
+package hellosign
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff     = 30 * time.Second
+)
+
+// RetryPolicy configures how a Client retries requests that failed with a transport error or a retryable status code.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one.  Defaults to 3.
+	MaxAttempts int
+	// Delay before the first retry.  Defaults to 500ms.  Each following delay is twice the previous one.
+	InitialBackoff time.Duration
+	// Upper bound of the delay between attempts, including delays requested by the API.  Defaults to 30 seconds.
+	MaxBackoff time.Duration
+	// Fraction (between 0 and 1) of each delay that is randomized, to spread out retries of concurrent callers.
+	Jitter float64
+	// ShouldRetry decides whether a failed attempt is retried, given either the response or the transport error.
+	// Defaults to [DefaultShouldRetry].  It is only consulted for requests whose body can be replayed.
+	ShouldRetry func(req *http.Request, resp *http.Response, err error) bool
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used by WithRetryPolicy for unset fields, with a jitter of 0.5.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    defaultRetryMaxAttempts,
+		InitialBackoff: defaultRetryInitialBackoff,
+		MaxBackoff:     defaultRetryMaxBackoff,
+		Jitter:         0.5,
+	}
+}
+
+// WithRetryPolicy configures the client to retry failed requests with exponential backoff.  When the API reports
+// when to retry, with a Retry-After or X-Ratelimit-Reset header, that delay is used instead, up to MaxBackoff.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		if policy.MaxAttempts <= 0 {
+			policy.MaxAttempts = defaultRetryMaxAttempts
+		}
+		if policy.InitialBackoff <= 0 {
+			policy.InitialBackoff = defaultRetryInitialBackoff
+		}
+		if policy.MaxBackoff <= 0 {
+			policy.MaxBackoff = defaultRetryMaxBackoff
+		}
+		if policy.ShouldRetry == nil {
+			policy.ShouldRetry = DefaultShouldRetry
+		}
+		c.retry = &policy
+	}
+}
+
+// DefaultShouldRetry retries requests rejected by rate limiting (status 429), which the API did not process.
+// Requests that are safe to repeat, GETs and POSTs to endpoints which do not modify anything, are also retried after
+// transport errors and 500, 502, 503 or 504 responses.
+func DefaultShouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if !isRetrySafe(req) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retrySafeKey is the context key marking requests as safe to repeat
+type retrySafeKey struct{}
+
+// withRetrySafe marks requests created with the returned context as safe to repeat, such as POSTs which do not
+// modify anything.
+func withRetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, true)
+}
+
+// isRetrySafe reports whether req can be repeated without side effects
+func isRetrySafe(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	safe, _ := req.Context().Value(retrySafeKey{}).(bool)
+	return safe
+}
+
+// send sends req, retrying it as configured by the client's RetryPolicy.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	policy := c.retry
+	if policy == nil {
+		return c.sendOnce(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.sendOnce(req)
+		if attempt >= policy.MaxAttempts || req.Context().Err() != nil || !canReplay(req) || !policy.ShouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		delay := policy.backoff(attempt, resp)
+		if resp != nil {
+			io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBodySize)) // Allows reusing the connection
+			resp.Body.Close()
+		}
+		if err := sleepContext(req.Context(), delay); err != nil {
+			return nil, err
+		}
+
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff returns the delay before the attempt following the given one, at most MaxBackoff
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if delay, ok := retryAfter(resp, time.Now()); ok {
+			return min(delay, p.MaxBackoff)
+		}
+	}
+
+	delay := p.MaxBackoff
+	if shift := attempt - 1; shift < 32 && p.InitialBackoff<<shift < p.MaxBackoff {
+		delay = p.InitialBackoff << shift
+	}
+	if p.Jitter > 0 {
+		delay -= time.Duration(p.Jitter * rand.Float64() * float64(delay))
+	}
+	return delay
+}
+
+// retryAfter returns how long the API asked to wait before retrying, from the Retry-After header (in seconds or as
+// an HTTP date) or, for rate limited requests, the X-Ratelimit-Reset header (a Unix timestamp).
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	header := resp.Header
+	if value := header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if at, err := http.ParseTime(value); err == nil {
+			return max(at.Sub(now), 0), true
+		}
+	}
+	if value := header.Get("X-Ratelimit-Reset"); value != "" && resp.StatusCode == http.StatusTooManyRequests {
+		if reset, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return max(time.Unix(reset, 0).Sub(now), 0), true
+		}
+	}
+	return 0, false
+}
+
+// canReplay reports whether the body of req can be sent again
+func canReplay(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewind returns a copy of req with a fresh body, to send it again
+func rewind(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("replaying request body: %w", err)
+		}
+		next.Body = body
+	}
+	return next, nil
+}
@@ -0,0 +1,132 @@
+package hellosign_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sean-rn/hellosign-sdk"
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFlakyServer responds to the first failures requests with the given status, then serves the create
+// embedded with template response, recording the bodies received
+func newFlakyServer(t *testing.T, failures, status int, header http.Header) (*httptest.Server, *[]string) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) <= failures {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			http.Error(w, `{"error": {"error_msg": "Try again", "error_name": "unavailable"}}`, status)
+			return
+		}
+		http.ServeFile(w, r, "testdata/create_embedded_with_template.resp.json")
+	}))
+	t.Cleanup(server.Close)
+	return server, &bodies
+}
+
+func TestRetryPolicy(t *testing.T) {
+	ctx := context.Background()
+	policy := hellosign.RetryPolicy{InitialBackoff: time.Millisecond, Jitter: 0.5}
+	createReq := model.CreateEmbeddedWithTemplateRequest{
+		ClientId:    "ddddb5e5c34b929957e24b17aa52dddd",
+		TemplateIds: []string{"cccc6ad681229567aab20cd83a69cf18fb2cccc"},
+		Signers: []model.SubSignatureRequestTemplateSigner{
+			{Role: "First", Name: "Signer One", EmailAddress: "signer.one@example.org"},
+		},
+	}
+
+	t.Run("retries GET on server errors", func(t *testing.T) {
+		server, bodies := newFlakyServer(t, 2, http.StatusServiceUnavailable, nil)
+		client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithRetryPolicy(policy))
+		_, err := client.GetSignatureRequest(ctx, "ebaae602348695a4c712aa0f22614986d03caaaa")
+		require.NoError(t, err)
+		assert.Len(t, *bodies, 3)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		server, bodies := newFlakyServer(t, 5, http.StatusBadGateway, nil)
+		client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithRetryPolicy(policy))
+		_, err := client.GetSignatureRequest(ctx, "ebaae602348695a4c712aa0f22614986d03caaaa")
+		var apiErr *hellosign.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+		assert.Equal(t, "unavailable", apiErr.ErrorName)
+		assert.Len(t, *bodies, 3)
+	})
+
+	t.Run("does not retry unsafe POST on server errors", func(t *testing.T) {
+		server, bodies := newFlakyServer(t, 1, http.StatusInternalServerError, nil)
+		client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithRetryPolicy(policy))
+		_, err := client.CreateEmbeddedWithTemplate(ctx, createReq)
+		assert.Error(t, err)
+		assert.Len(t, *bodies, 1)
+	})
+
+	t.Run("replays POST body when rate limited", func(t *testing.T) {
+		reset := strconv.FormatInt(time.Now().Add(-time.Second).Unix(), 10)
+		server, bodies := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"X-Ratelimit-Reset": {reset}})
+		client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithRetryPolicy(policy))
+		_, err := client.CreateEmbeddedWithTemplate(ctx, createReq)
+		require.NoError(t, err)
+		require.Len(t, *bodies, 2)
+		assert.Contains(t, (*bodies)[0], "ddddb5e5c34b929957e24b17aa52dddd")
+		assert.Equal(t, (*bodies)[0], (*bodies)[1])
+	})
+
+	t.Run("replays multipart body", func(t *testing.T) {
+		server, bodies := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"0"}})
+		client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithRetryPolicy(policy))
+		req := createReq
+		req.Files = []*model.File{model.FileFromBytes("contract.pdf", []byte("%PDF-1.4"))}
+		_, err := client.CreateEmbeddedWithTemplate(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, *bodies, 2)
+		assert.Contains(t, (*bodies)[1], "%PDF-1.4")
+		assert.Equal(t, (*bodies)[0], (*bodies)[1])
+	})
+
+	t.Run("does not retry body that cannot be replayed", func(t *testing.T) {
+		server, bodies := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"0"}})
+		client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithRetryPolicy(policy))
+		req := createReq
+		req.Files = []*model.File{model.FileFromReader("contract.pdf", strings.NewReader("%PDF-1.4"))}
+		_, err := client.CreateEmbeddedWithTemplate(ctx, req)
+		assert.True(t, hellosign.IsRateLimited(err))
+		assert.Len(t, *bodies, 1)
+	})
+
+	t.Run("caps the delay requested by the API", func(t *testing.T) {
+		server, bodies := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"3600"}})
+		capped := policy
+		capped.MaxBackoff = 10 * time.Millisecond
+		client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithRetryPolicy(capped))
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		_, err := client.GetSignatureRequest(ctx, "ebaae602348695a4c712aa0f22614986d03caaaa")
+		require.NoError(t, err)
+		assert.Len(t, *bodies, 2)
+	})
+
+	t.Run("stops when context is canceled", func(t *testing.T) {
+		server, bodies := newFlakyServer(t, 5, http.StatusTooManyRequests, http.Header{"Retry-After": {"60"}})
+		client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithRetryPolicy(policy))
+		ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		_, err := client.GetSignatureRequest(ctx, "ebaae602348695a4c712aa0f22614986d03caaaa")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Len(t, *bodies, 1)
+	})
+}
@@ -0,0 +1,278 @@
+package hellosign
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sean-rn/hellosign-sdk/model"
+)
+
+// TemplateProblemKind identifies the kind of a TemplateProblem.
+type TemplateProblemKind string
+
+// Kinds of problems found by a TemplateValidator
+const (
+	ProblemUnknownSignerRole   TemplateProblemKind = "unknown_signer_role"   // A signer has a role the templates do not define.
+	ProblemDuplicateSignerRole TemplateProblemKind = "duplicate_signer_role" // Several signers have the same role.
+	ProblemMissingSignerRole   TemplateProblemKind = "missing_signer_role"   // A signer role of the templates has no signer.
+	ProblemUnknownCCRole       TemplateProblemKind = "unknown_cc_role"       // A CC has a role the templates do not define.
+	ProblemDuplicateCCRole     TemplateProblemKind = "duplicate_cc_role"     // Several CCs have the same role.
+	ProblemMissingCCRole       TemplateProblemKind = "missing_cc_role"       // A CC role of the templates has no CC.
+	ProblemMissingCustomField  TemplateProblemKind = "missing_custom_field"  // A required custom field of the templates, filled in by the sender, has no value.
+	ProblemUnknownEditor       TemplateProblemKind = "unknown_editor"        // A custom field's editor is not a signer role.
+)
+
+// TemplateProblem describes a mismatch between a request and the templates it uses.
+type TemplateProblem struct {
+	Kind       TemplateProblemKind // What is wrong.
+	Field      string              // Path of the offending request field, e.g. "signers[1].role".
+	TemplateId string              // Id of the template defining the role or field concerned, if any.
+	Message    string              // Human readable description of the problem.
+}
+
+// String implements fmt.Stringer
+func (p TemplateProblem) String() string {
+	return p.Field + ": " + p.Message
+}
+
+// TemplateValidationError is returned by the Validate methods of TemplateValidator when a request does not match
+// its templates.
+type TemplateValidationError struct {
+	Problems []TemplateProblem
+}
+
+// Error implements the error interface
+func (e *TemplateValidationError) Error() string {
+	msgs := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		msgs[i] = p.String()
+	}
+	return "request does not match its templates: " + strings.Join(msgs, "; ")
+}
+
+// TemplateValidator checks requests sent with templates against the definitions of those templates before they are
+// sent, catching mistakes like misspelled roles that the API would otherwise only report after a round-trip.
+// Templates are fetched with GetTemplate and cached.  It is safe for concurrent use.
+type TemplateValidator struct {
+	client *Client
+	ttl    time.Duration
+
+	mu        sync.Mutex
+	templates map[string]cachedTemplate
+}
+
+// cachedTemplate is a template definition held by a TemplateValidator
+type cachedTemplate struct {
+	template model.TemplateResponse
+	fetched  time.Time
+}
+
+// NewTemplateValidator creates a TemplateValidator fetching templates with client, and caching them for ttl.
+// A ttl of zero caches templates until they are forgotten with Forget.
+func NewTemplateValidator(client *Client, ttl time.Duration) *TemplateValidator {
+	return &TemplateValidator{client: client, ttl: ttl, templates: make(map[string]cachedTemplate)}
+}
+
+// Forget removes the given templates from the cache, or all of them if no template id is given.  Call it after
+// editing a template.
+func (v *TemplateValidator) Forget(templateIds ...string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if len(templateIds) == 0 {
+		clear(v.templates)
+	}
+	for _, id := range templateIds {
+		delete(v.templates, id)
+	}
+}
+
+// CheckCreateEmbeddedWithTemplate returns the problems of req with regard to its templates.  The error is only set
+// if the templates could not be fetched.
+func (v *TemplateValidator) CheckCreateEmbeddedWithTemplate(ctx context.Context, req model.CreateEmbeddedWithTemplateRequest) ([]TemplateProblem, error) {
+	return v.check(ctx, req.TemplateIds, req.Signers, req.CCs, req.CustomFields)
+}
+
+// CheckSendWithTemplate returns the problems of req with regard to its templates.  The error is only set if the
+// templates could not be fetched.
+func (v *TemplateValidator) CheckSendWithTemplate(ctx context.Context, req model.SendWithTemplateRequest) ([]TemplateProblem, error) {
+	return v.check(ctx, req.TemplateIds, req.Signers, req.CCs, req.CustomFields)
+}
+
+// ValidateCreateEmbeddedWithTemplate is like CheckCreateEmbeddedWithTemplate, but returns the problems found as a
+// *TemplateValidationError.
+func (v *TemplateValidator) ValidateCreateEmbeddedWithTemplate(ctx context.Context, req model.CreateEmbeddedWithTemplateRequest) error {
+	return problemsError(v.CheckCreateEmbeddedWithTemplate(ctx, req))
+}
+
+// ValidateSendWithTemplate is like CheckSendWithTemplate, but returns the problems found as a
+// *TemplateValidationError.
+func (v *TemplateValidator) ValidateSendWithTemplate(ctx context.Context, req model.SendWithTemplateRequest) error {
+	return problemsError(v.CheckSendWithTemplate(ctx, req))
+}
+
+// problemsError wraps problems in a *TemplateValidationError, unless err is set or there are no problems
+func problemsError(problems []TemplateProblem, err error) error {
+	if err != nil {
+		return err
+	}
+	if len(problems) > 0 {
+		return &TemplateValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// check compares the roles and custom fields of a request with those defined by its templates
+func (v *TemplateValidator) check(ctx context.Context, templateIds []string, signers []model.SubSignatureRequestTemplateSigner,
+	ccs []model.SubCC, customFields []model.SubCustomField) ([]TemplateProblem, error) {
+	var signerRoles, ccRoles, requiredFields roleSet
+	for _, id := range templateIds {
+		template, err := v.template(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("fetching template %s: %w", id, err)
+		}
+		for _, role := range template.SignerRoles {
+			signerRoles.add(role.Name, id)
+		}
+		for _, role := range template.CCRoles {
+			ccRoles.add(role.Name, id)
+		}
+		for _, doc := range template.Documents {
+			for _, field := range doc.CustomFields {
+				if field.Required && (field.Signer == "" || field.Signer == "sender") { // Others are filled in by signers
+					requiredFields.add(field.Name, id)
+				}
+			}
+		}
+	}
+
+	var problems []TemplateProblem
+	signerRoleNames := make([]string, len(signers))
+	for i, s := range signers {
+		signerRoleNames[i] = s.Role
+	}
+	problems = append(problems, checkRoles("signers", "signer", signerRoleNames, &signerRoles,
+		ProblemUnknownSignerRole, ProblemDuplicateSignerRole, ProblemMissingSignerRole)...)
+	ccRoleNames := make([]string, len(ccs))
+	for i, cc := range ccs {
+		ccRoleNames[i] = cc.Role
+	}
+	problems = append(problems, checkRoles("ccs", "CC", ccRoleNames, &ccRoles,
+		ProblemUnknownCCRole, ProblemDuplicateCCRole, ProblemMissingCCRole)...)
+
+	provided := make(map[string]bool)
+	for i, field := range customFields {
+		if field.Value != "" || signerRoles.has(field.Editor) { // A field with an editor is filled in by that signer
+			provided[field.Name] = true
+		}
+		if field.Editor != "" && !signerRoles.has(field.Editor) {
+			problems = append(problems, TemplateProblem{
+				Kind:    ProblemUnknownEditor,
+				Field:   fmt.Sprintf("custom_fields[%d].editor", i),
+				Message: fmt.Sprintf("editor %q is not a signer role of the templates%s", field.Editor, signerRoles.suggest(field.Editor)),
+			})
+		}
+	}
+	for _, name := range requiredFields.names {
+		if !provided[name] {
+			problems = append(problems, TemplateProblem{
+				Kind:       ProblemMissingCustomField,
+				Field:      "custom_fields",
+				TemplateId: requiredFields.templates[name],
+				Message:    fmt.Sprintf("required custom field %q has no value", name),
+			})
+		}
+	}
+	return problems, nil
+}
+
+// checkRoles checks that each role of roles is assigned exactly once by the request field named field
+func checkRoles(field, what string, assigned []string, roles *roleSet, unknown, duplicate, missing TemplateProblemKind) []TemplateProblem {
+	var problems []TemplateProblem
+	seen := make(map[string]int)
+	for i, role := range assigned {
+		path := fmt.Sprintf("%s[%d].role", field, i)
+		switch first, dup := seen[role]; {
+		case !roles.has(role):
+			problems = append(problems, TemplateProblem{
+				Kind:    unknown,
+				Field:   path,
+				Message: fmt.Sprintf("%s role %q is not defined by the templates%s", what, role, roles.suggest(role)),
+			})
+		case dup:
+			problems = append(problems, TemplateProblem{
+				Kind:       duplicate,
+				Field:      path,
+				TemplateId: roles.templates[role],
+				Message:    fmt.Sprintf("%s role %q is already assigned by %s[%d]", what, role, field, first),
+			})
+		default:
+			seen[role] = i
+		}
+	}
+	for _, role := range roles.names {
+		if _, ok := seen[role]; !ok {
+			problems = append(problems, TemplateProblem{
+				Kind:       missing,
+				Field:      field,
+				TemplateId: roles.templates[role],
+				Message:    fmt.Sprintf("%s role %q is not assigned", what, role),
+			})
+		}
+	}
+	return problems
+}
+
+// roleSet is an ordered set of the names of roles or fields, with the template defining each
+type roleSet struct {
+	names     []string
+	templates map[string]string
+}
+
+// add adds name, defined by the given template, to the set
+func (s *roleSet) add(name, templateId string) {
+	if s.templates == nil {
+		s.templates = make(map[string]string)
+	}
+	if _, ok := s.templates[name]; !ok {
+		s.names = append(s.names, name)
+		s.templates[name] = templateId
+	}
+}
+
+// has reports whether name is in the set, case-sensitively
+func (s *roleSet) has(name string) bool {
+	_, ok := s.templates[name]
+	return ok
+}
+
+// suggest returns a hint naming the element of the set differing from name only by case, if any
+func (s *roleSet) suggest(name string) string {
+	for _, n := range s.names {
+		if strings.EqualFold(n, name) {
+			return fmt.Sprintf(" (did you mean %q? roles are case-sensitive)", n)
+		}
+	}
+	return ""
+}
+
+// template returns the definition of a template, from the cache if it is fresh enough
+func (v *TemplateValidator) template(ctx context.Context, templateId string) (*model.TemplateResponse, error) {
+	v.mu.Lock()
+	cached, ok := v.templates[templateId]
+	v.mu.Unlock()
+	if ok && (v.ttl == 0 || time.Since(cached.fetched) < v.ttl) {
+		return &cached.template, nil
+	}
+
+	resp, err := v.client.GetTemplate(ctx, templateId)
+	if err != nil {
+		return nil, err
+	}
+	v.mu.Lock()
+	v.templates[templateId] = cachedTemplate{template: resp.Template, fetched: time.Now()}
+	v.mu.Unlock()
+	return &resp.Template, nil
+}
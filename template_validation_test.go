@@ -0,0 +1,104 @@
+package hellosign_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sean-rn/hellosign-sdk"
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateValidator(t *testing.T) {
+	fetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/template/f57db65d3f933b5316d398057a36176831451a35" {
+			http.NotFound(w, r)
+			return
+		}
+		fetches++
+		http.ServeFile(w, r, "testdata/get_template.resp.json")
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := hellosign.NewClient(hellosign.WithBaseURL(server.URL), hellosign.WithApiKey("test-api-key"))
+	validator := hellosign.NewTemplateValidator(client, 0)
+
+	t.Run("accepts matching request", func(t *testing.T) {
+		err := validator.ValidateCreateEmbeddedWithTemplate(ctx, model.CreateEmbeddedWithTemplateRequest{
+			TemplateIds: []string{"f57db65d3f933b5316d398057a36176831451a35"},
+			Signers: []model.SubSignatureRequestTemplateSigner{
+				{Role: "Client", Name: "Signer One", EmailAddress: "signer.one@example.org"},
+				{Role: "Witness", Name: "Signer Two", EmailAddress: "signer.two@example.org"},
+			},
+			CCs:          []model.SubCC{{Role: "Manager", EmailAddress: "manager@example.org"}},
+			CustomFields: []model.SubCustomField{{Name: "Effective Date", Value: "2024-01-01", Editor: "Client"}},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("accepts required custom field filled in by its editor", func(t *testing.T) {
+		problems, err := validator.CheckCreateEmbeddedWithTemplate(ctx, model.CreateEmbeddedWithTemplateRequest{
+			TemplateIds: []string{"f57db65d3f933b5316d398057a36176831451a35"},
+			Signers: []model.SubSignatureRequestTemplateSigner{
+				{Role: "Client", Name: "Signer One", EmailAddress: "signer.one@example.org"},
+				{Role: "Witness", Name: "Signer Two", EmailAddress: "signer.two@example.org"},
+			},
+			CCs:          []model.SubCC{{Role: "Manager", EmailAddress: "manager@example.org"}},
+			CustomFields: []model.SubCustomField{{Name: "Effective Date", Editor: "Client", Required: true}},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, problems)
+	})
+
+	t.Run("reports problems", func(t *testing.T) {
+		problems, err := validator.CheckSendWithTemplate(ctx, model.SendWithTemplateRequest{
+			TemplateIds: []string{"f57db65d3f933b5316d398057a36176831451a35"},
+			Signers: []model.SubSignatureRequestTemplateSigner{
+				{Role: "client", Name: "Signer One", EmailAddress: "signer.one@example.org"},
+				{Role: "Witness", Name: "Signer Two", EmailAddress: "signer.two@example.org"},
+				{Role: "Witness", Name: "Signer Three", EmailAddress: "signer.three@example.org"},
+			},
+			CustomFields: []model.SubCustomField{{Name: "Effective Date", Editor: "Lawyer"}},
+		})
+		require.NoError(t, err)
+
+		var kinds, fields []string
+		for _, p := range problems {
+			kinds = append(kinds, string(p.Kind))
+			fields = append(fields, p.Field)
+		}
+		assert.Equal(t, []string{
+			"unknown_signer_role", "duplicate_signer_role", "missing_signer_role", "missing_cc_role", "unknown_editor", "missing_custom_field",
+		}, kinds)
+		assert.Equal(t, []string{
+			"signers[0].role", "signers[2].role", "signers", "ccs", "custom_fields[0].editor", "custom_fields",
+		}, fields)
+		assert.Contains(t, problems[0].Message, `did you mean "Client"?`)
+		assert.Contains(t, problems[5].Message, `"Effective Date"`, "fields filled in by signers are not missing")
+		assert.Equal(t, "f57db65d3f933b5316d398057a36176831451a35", problems[2].TemplateId)
+
+		var validationErr *hellosign.TemplateValidationError
+		err = validator.ValidateSendWithTemplate(ctx, model.SendWithTemplateRequest{TemplateIds: []string{"f57db65d3f933b5316d398057a36176831451a35"}})
+		require.ErrorAs(t, err, &validationErr)
+		assert.Len(t, validationErr.Problems, 4)
+	})
+
+	t.Run("caches templates", func(t *testing.T) {
+		assert.Equal(t, 1, fetches)
+		validator.Forget("f57db65d3f933b5316d398057a36176831451a35")
+		_, err := validator.CheckSendWithTemplate(ctx, model.SendWithTemplateRequest{TemplateIds: []string{"f57db65d3f933b5316d398057a36176831451a35"}})
+		require.NoError(t, err)
+		assert.Equal(t, 2, fetches)
+	})
+
+	t.Run("fails when template cannot be fetched", func(t *testing.T) {
+		_, err := validator.CheckSendWithTemplate(ctx, model.SendWithTemplateRequest{TemplateIds: []string{"missing"}})
+		assert.True(t, hellosign.IsNotFound(err))
+	})
+}
@@ -0,0 +1,107 @@
+package hellosign
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/sean-rn/hellosign-sdk/model"
+)
+
+const (
+	// EventCallbackAck is the response body Dropbox Sign requires from a callback URL. If it is not returned
+	// the event is considered undelivered and will be retried.
+	EventCallbackAck = "Hello API Event Received"
+
+	// maxWebhookBodySize limits the size of the event callback request bodies accepted by WebhookHandler
+	maxWebhookBodySize = 10 << 20
+)
+
+// ErrMalformedEventCallback is wrapped by errors from WebhookHandler when the request does not contain a parsable event.
+var ErrMalformedEventCallback = errors.New("malformed event callback")
+
+// WebhookHandler is an [http.Handler] that receives Dropbox Sign event callbacks.  It parses the `json` form field
+// of the POSTed request, verifies its event hash, dispatches it to the callback registered for its event type and
+// replies with [EventCallbackAck].  Callbacks are dispatched by the embedded EventRouter; those whose event type
+// has no handler are acknowledged and otherwise ignored.
+type WebhookHandler struct {
+	EventRouter
+
+	// Verifier checks the event hash of each callback.  If nil, event hashes are NOT verified.
+	Verifier *EventVerifier
+	// ErrorHandler writes the response when a callback cannot be processed.  If nil, [DefaultWebhookErrorHandler] is used.
+	ErrorHandler func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// Assert that *WebhookHandler implements http.Handler
+var _ http.Handler = (*WebhookHandler)(nil)
+
+// NewWebhookHandler creates a WebhookHandler verifying event hashes with verifier.
+func NewWebhookHandler(verifier *EventVerifier) *WebhookHandler {
+	return &WebhookHandler{Verifier: verifier}
+}
+
+// ServeHTTP implements http.Handler
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := h.serve(r); err != nil {
+		errorHandler := h.ErrorHandler
+		if errorHandler == nil {
+			errorHandler = DefaultWebhookErrorHandler
+		}
+		errorHandler(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	io.WriteString(w, EventCallbackAck)
+}
+
+// serve parses, verifies and dispatches the event callback in r
+func (h *WebhookHandler) serve(r *http.Request) error {
+	event, err := ParseEventCallback(r)
+	if err != nil {
+		return err
+	}
+	if h.Verifier != nil {
+		if err := h.Verifier.Verify(event); err != nil {
+			return err
+		}
+	}
+	return h.Dispatch(r.Context(), event)
+}
+
+// ParseEventCallback extracts the event from the `json` field of an event callback request, which Dropbox Sign
+// POSTs as multipart/form-data. The event hash is not verified.
+func ParseEventCallback(r *http.Request) (*model.EventCallbackRequest, error) {
+	if r.Method != http.MethodPost {
+		return nil, fmt.Errorf("%w: unexpected method %s", ErrMalformedEventCallback, r.Method)
+	}
+	r.Body = http.MaxBytesReader(nil, r.Body, maxWebhookBodySize)
+	if err := r.ParseMultipartForm(maxWebhookBodySize); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+		return nil, fmt.Errorf("%w: parsing form: %w", ErrMalformedEventCallback, err)
+	}
+
+	payload := r.PostFormValue("json")
+	if payload == "" {
+		return nil, fmt.Errorf("%w: missing json field", ErrMalformedEventCallback)
+	}
+	var event model.EventCallbackRequest
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		return nil, fmt.Errorf("%w: decoding json field: %w", ErrMalformedEventCallback, err)
+	}
+	return &event, nil
+}
+
+// DefaultWebhookErrorHandler responds with 400 Bad Request to malformed callbacks, 401 Unauthorized to callbacks
+// failing event hash verification, and 500 Internal Server Error when an EventHandlerFunc fails.
+func DefaultWebhookErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, ErrMalformedEventCallback):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, ErrInvalidEventHash):
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,99 @@
+package hellosign_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sean-rn/hellosign-sdk"
+	"github.com/sean-rn/hellosign-sdk/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEventCallbackRequest builds a multipart POST like Dropbox Sign sends to callback URLs
+func newEventCallbackRequest(t *testing.T, eventType model.EventType, eventHash string) *http.Request {
+	t.Helper()
+	payload := `{"event": {"event_time": "1730139997", "event_type": "` + string(eventType) + `", "event_hash": "` + eventHash + `"},
+		"signature_request": {"signature_request_id": "ebaae602348695a4c712aa0f22614986d03caaaa"}}`
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	require.NoError(t, mw.WriteField("json", payload))
+	require.NoError(t, mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/callbacks/dropbox-sign", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return req
+}
+
+// signEvent computes the event hash for the events built by newEventCallbackRequest
+func signEvent(t *testing.T, apiKey string, eventType model.EventType) string {
+	t.Helper()
+	var event model.EventCallbackRequestEvent
+	event.EventType = eventType
+	event.EventTime.Time = time.Unix(1730139997, 0)
+	return hellosign.ComputeEventHash(apiKey, event)
+}
+
+func TestWebhookHandler(t *testing.T) {
+	handler := hellosign.NewWebhookHandler(&hellosign.EventVerifier{ApiKey: "test-api-key"})
+
+	var signed []string
+	handler.HandleFunc(model.EventTypeSignatureRequestSigned, func(ctx context.Context, req *model.EventCallbackRequest) error {
+		signed = append(signed, req.SignatureRequest.SignatureRequestId)
+		return nil
+	})
+	handler.HandleFunc(model.EventTypeSignatureRequestDeclined, func(ctx context.Context, req *model.EventCallbackRequest) error {
+		return errors.New("database unavailable")
+	})
+
+	t.Run("dispatches and acknowledges", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newEventCallbackRequest(t, model.EventTypeSignatureRequestSigned, signEvent(t, "test-api-key", model.EventTypeSignatureRequestSigned)))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, hellosign.EventCallbackAck, rec.Body.String())
+		assert.Equal(t, []string{"ebaae602348695a4c712aa0f22614986d03caaaa"}, signed)
+	})
+
+	t.Run("acknowledges unhandled events", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newEventCallbackRequest(t, model.EventTypeCallbackTest, signEvent(t, "test-api-key", model.EventTypeCallbackTest)))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, hellosign.EventCallbackAck, rec.Body.String())
+	})
+
+	t.Run("rejects invalid hash", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newEventCallbackRequest(t, model.EventTypeSignatureRequestSigned, signEvent(t, "wrong-key", model.EventTypeSignatureRequestSigned)))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Len(t, signed, 1)
+	})
+
+	t.Run("rejects malformed request", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/callbacks/dropbox-sign", strings.NewReader("json=nope")))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("reports handler errors", func(t *testing.T) {
+		var handled error
+		handler.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+			handled = err
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		t.Cleanup(func() { handler.ErrorHandler = nil })
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newEventCallbackRequest(t, model.EventTypeSignatureRequestDeclined, signEvent(t, "test-api-key", model.EventTypeSignatureRequestDeclined)))
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.EqualError(t, handled, "database unavailable")
+	})
+}